@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// ID references an existing person in a batch.
+func ID(id int64) internal.PersonRef {
+	return internal.PersonRef{ID: id}
+}
+
+// Ref references a person created earlier in the same batch.
+func Ref(ref string) internal.PersonRef {
+	return internal.PersonRef{Ref: ref}
+}
+
+// Batch builds an ordered list of operations applied atomically by Commit, either every
+// operation is applied or none.
+//
+// people created by the batch are referenced by later operations with Ref:
+//
+//	res, err := c.NewBatch().
+//		AddPerson("alice", &internal.Person{Name: "Alice"}).
+//		AddFriendship(client.Ref("alice"), client.ID(1)).
+//		Commit(ctx)
+type Batch struct {
+	c   *Client
+	ops []internal.BatchOp
+}
+
+// NewBatch creates an empty batch.
+func (c *Client) NewBatch() *Batch {
+	return &Batch{c: c}
+}
+
+// AddPerson adds person, ref names the person for later operations and can be empty.
+func (b *Batch) AddPerson(ref string, person *internal.Person) *Batch {
+	return b.Op(internal.BatchOp{Op: internal.AddPersonOp, Ref: ref, Person: person})
+}
+
+// AddFriendship adds a one-way friendship from p1 to p2.
+func (b *Batch) AddFriendship(p1, p2 internal.PersonRef) *Batch {
+	return b.Op(internal.BatchOp{Op: internal.AddFriendshipOp, From: p1, To: p2})
+}
+
+// AddMutualFriendship adds a friendship from p1 to p2 and from p2 to p1.
+func (b *Batch) AddMutualFriendship(p1, p2 internal.PersonRef) *Batch {
+	return b.Op(internal.BatchOp{Op: internal.AddFriendshipOp, From: p1, To: p2, Mutual: true})
+}
+
+// AddTypedFriendship adds a one-way relationship of kind with weight from p1 to p2.
+func (b *Batch) AddTypedFriendship(p1, p2 internal.PersonRef, kind internal.RelationType, weight float64) *Batch {
+	return b.Op(internal.BatchOp{Op: internal.AddFriendshipOp, From: p1, To: p2, Type: kind, Weight: weight})
+}
+
+// RemoveFriendship removes the friendship (of the default type) from p1 to p2.
+func (b *Batch) RemoveFriendship(p1, p2 internal.PersonRef) *Batch {
+	return b.Op(internal.BatchOp{Op: internal.RemoveFriendshipOp, From: p1, To: p2})
+}
+
+// RemovePerson removes the person alongside all their friendships.
+func (b *Batch) RemovePerson(person internal.PersonRef) *Batch {
+	return b.Op(internal.BatchOp{Op: internal.RemovePersonOp, Target: person})
+}
+
+// Op appends any operation to the batch.
+func (b *Batch) Op(op internal.BatchOp) *Batch {
+	b.ops = append(b.ops, op)
+	return b
+}
+
+// Len returns the number of operations in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
+// Commit sends the batch, the result holds the outcome of each operation in order and the
+// ids of the people created by ref.
+func (b *Batch) Commit(ctx context.Context) (internal.BatchResult, error) {
+	return b.c.client.Batch(ctx, b.ops)
+}
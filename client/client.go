@@ -3,14 +3,19 @@ package client
 //TODO: Add more examples in readme.
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/Lambels/relationer/internal"
 	rClient "github.com/Lambels/relationer/internal/client"
+	"github.com/Lambels/relationer/internal/importer"
+	"github.com/Lambels/relationer/internal/rpc"
 	"github.com/Lambels/relationer/internal/service"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -20,7 +25,7 @@ const (
 
 // Client is the interface between the user and the relationer server.
 type Client struct {
-	// REST client implementation.
+	// REST or gRPC client implementation.
 	client service.GraphStore
 
 	// The consumer factory config.
@@ -37,6 +42,10 @@ type ClientConfig struct {
 	URL string
 	// The http client used by the REST client - defualt: http client with 5 second timeout
 	Client *http.Client
+	// The gRPC connection to the relationer server, when set the client uses the gRPC api
+	// instead of the REST api and URL and Client are ignored. The connection is owned by
+	// the caller.
+	Conn grpc.ClientConnInterface
 
 	// The consumer factory configuration used by the client to generate all consumers.
 	ConsumerConfig *ConsumerConfig
@@ -54,7 +63,11 @@ func New(conf *ClientConfig) *Client {
 	if conf == nil {
 		c.client = rClient.NewClient(&http.Client{Timeout: DefaultTimeout}, DefaultURL)
 	} else {
-		c.client = rClient.NewClient(conf.Client, conf.URL)
+		if conf.Conn != nil {
+			c.client = rpc.NewClient(conf.Conn)
+		} else {
+			c.client = rClient.NewClient(conf.Client, conf.URL)
+		}
 		c.consConf = conf.ConsumerConfig
 	}
 	return c
@@ -77,14 +90,95 @@ func (c *Client) Close() error {
 // TODO: parse errors // unwrap from internal client.
 // REST -------------------------------------------------------------------------------------
 
-// GetDepth gets the depth between two nodes (including the endpoint nodes).
-func (c *Client) GetDepth(ctx context.Context, id1, id2 int64) (int, error) {
-	return c.client.GetDepth(ctx, id1, id2)
+// GetDepth gets the depth between two nodes (including the endpoint nodes), only following
+// friendships of the provided types (all types when none are provided).
+func (c *Client) GetDepth(ctx context.Context, id1, id2 int64, types ...internal.RelationType) (int, error) {
+	return c.client.GetDepth(ctx, id1, id2, internal.EdgeFilter{Types: types})
+}
+
+// GetDepthAsOf gets the depth between two nodes as it was at asOf, including people and
+// friendships removed since.
+func (c *Client) GetDepthAsOf(ctx context.Context, id1, id2 int64, asOf time.Time, types ...internal.RelationType) (int, error) {
+	return c.client.GetDepth(ctx, id1, id2, internal.EdgeFilter{Types: types, AsOf: asOf})
+}
+
+// GetPath gets the shortest path between two nodes (including the endpoint nodes), if the
+// nodes arent connected the path will be empty.
+func (c *Client) GetPath(ctx context.Context, id1, id2 int64) ([]*internal.Person, error) {
+	return c.client.GetPath(ctx, id1, id2)
+}
+
+// GetWeightedPath gets the cheapest path between two nodes (including the endpoint nodes)
+// where each hop costs the inverse of the friendship weight. If the nodes arent connected
+// the path is empty.
+func (c *Client) GetWeightedPath(ctx context.Context, id1, id2 int64) (internal.WeightedPath, error) {
+	return c.client.GetWeightedPath(ctx, id1, id2)
+}
+
+// GetPaths gets up to limit distinct simple paths between two nodes (including the endpoint nodes)
+// each at most maxLength hops long, maxLength is at most 6 and limit at most 1000.
+func (c *Client) GetPaths(ctx context.Context, id1, id2 int64, maxLength, limit int) ([][]*internal.Person, error) {
+	return c.client.GetPaths(ctx, id1, id2, maxLength, limit)
+}
+
+// GetNetwork gets everyone within depth hops of the person with id: id alongside their distance,
+// set withEdges to also get the friendships between the people in the network.
+func (c *Client) GetNetwork(ctx context.Context, id int64, depth int, withEdges bool) (internal.Network, error) {
+	return c.client.GetNetwork(ctx, id, depth, withEdges)
+}
+
+// GetMutual gets the people both id1 and id2 are friends with.
+func (c *Client) GetMutual(ctx context.Context, id1, id2 int64) ([]*internal.Person, error) {
+	return c.client.GetMutual(ctx, id1, id2)
+}
+
+// GetRecommendations gets up to limit people the person with id: id may know, ranked by the
+// algorithm: internal.CommonNeighbours, internal.Jaccard or internal.AdamicAdar.
+func (c *Client) GetRecommendations(ctx context.Context, id int64, limit int, algorithm internal.Algorithm) ([]internal.Recommendation, error) {
+	return c.client.GetRecommendations(ctx, id, limit, algorithm)
+}
+
+// GetClusters gets the clusters of kind: internal.WeakComponent, internal.StrongComponent or
+// internal.Community ordered from the biggest to the smallest.
+func (c *Client) GetClusters(ctx context.Context, kind internal.ClusterKind) ([]internal.Cluster, error) {
+	return c.client.GetClusters(ctx, kind)
+}
+
+// GetStats gets graph-wide statistics: counts, degree distributions, density, components,
+// diameter and radius (estimated on big graphs) and the average clustering coefficient.
+func (c *Client) GetStats(ctx context.Context) (internal.Stats, error) {
+	return c.client.GetStats(ctx)
+}
+
+// Query runs q, written in the query language, and returns the matched rows:
+//
+//	MATCH (a {name: "Alice"})-[:friend*1..2]->(b) WHERE b.team = "infra" RETURN b LIMIT 10
+//
+// the server stops the query once the deadline of ctx passes, or after its default timeout.
+func (c *Client) Query(ctx context.Context, q string) (internal.QueryResult, error) {
+	return c.client.Query(ctx, q)
+}
+
+// GetCentrality gets the centrality scores of the person with id: id, damping is the PageRank
+// damping factor (internal.DefaultDamping is a sensible value).
+func (c *Client) GetCentrality(ctx context.Context, id int64, damping float64) (internal.Centrality, error) {
+	return c.client.GetCentrality(ctx, id, damping)
+}
+
+// GetTopCentrality gets up to limit people with the highest metric score.
+func (c *Client) GetTopCentrality(ctx context.Context, metric internal.Metric, limit int, damping float64) ([]internal.Ranked, error) {
+	return c.client.GetTopCentrality(ctx, metric, limit, damping)
+}
+
+// GetFriendship gets the friendships (relationships) the person with id: id has, only
+// including friendships of the provided types (all types when none are provided).
+func (c *Client) GetFriendship(ctx context.Context, id int64, types ...internal.RelationType) (internal.Friendship, error) {
+	return c.client.GetFriendship(ctx, id, internal.EdgeFilter{Types: types})
 }
 
-// GetFriendship gets the friendships (relationships) the person with id: id has.
-func (c *Client) GetFriendship(ctx context.Context, id int64) (internal.Friendship, error) {
-	return c.client.GetFriendship(ctx, id)
+// GetFriendshipAsOf gets the friendships the person with id: id had at asOf.
+func (c *Client) GetFriendshipAsOf(ctx context.Context, id int64, asOf time.Time, types ...internal.RelationType) (internal.Friendship, error) {
+	return c.client.GetFriendship(ctx, id, internal.EdgeFilter{Types: types, AsOf: asOf})
 }
 
 // GetPerson fetches the person with id: id.
@@ -92,9 +186,85 @@ func (c *Client) GetPerson(ctx context.Context, id int64) (*internal.Person, err
 	return c.client.GetPerson(ctx, id)
 }
 
-// GetAll returns the graph of the current state.
-func (c *Client) GetAll(ctx context.Context) ([]internal.Friendship, error) {
-	return c.client.GetAll(ctx)
+// FindPeople gets the people having every property in where, ie: FindPeople(ctx, internal.Property{Key: "team", Value: "infra"}).
+// No properties match everyone.
+func (c *Client) FindPeople(ctx context.Context, where ...internal.Property) ([]*internal.Person, error) {
+	return c.client.FindPeople(ctx, where)
+}
+
+// SearchPeople searches people by name with prefix and typo tolerant matching, returning up
+// to limit results ranked best first.
+func (c *Client) SearchPeople(ctx context.Context, query string, limit int) ([]internal.SearchResult, error) {
+	return c.client.SearchPeople(ctx, query, limit)
+}
+
+// GetAll returns the graph of the current state, only including friendships of the provided
+// types (all types when none are provided).
+func (c *Client) GetAll(ctx context.Context, types ...internal.RelationType) ([]internal.Friendship, error) {
+	return c.client.GetAll(ctx, internal.EdgeFilter{Types: types})
+}
+
+// GetAllAsOf returns the graph as it was at asOf.
+func (c *Client) GetAllAsOf(ctx context.Context, asOf time.Time, types ...internal.RelationType) ([]internal.Friendship, error) {
+	return c.client.GetAll(ctx, internal.EdgeFilter{Types: types, AsOf: asOf})
+}
+
+// FriendshipIterator walks the friendships of everyone in id order, fetching a page at a time.
+type FriendshipIterator = rClient.Iterator
+
+// Iterate creates an iterator over the friendships of everyone fetching pageSize people per
+// request, only including friendships of the provided types (all types when none are provided).
+//
+// prefer it over GetAll on big graphs.
+func (c *Client) Iterate(ctx context.Context, pageSize int, types ...internal.RelationType) *FriendshipIterator {
+	return rClient.NewIterator(ctx, c.client, internal.EdgeFilter{Types: types}, pageSize)
+}
+
+// GetPage gets the friendships of up to limit people with ids after cursor, the next cursor
+// is 0 on the last page.
+func (c *Client) GetPage(ctx context.Context, cursor int64, limit int, types ...internal.RelationType) (internal.FriendshipPage, error) {
+	return c.client.GetPage(ctx, internal.EdgeFilter{Types: types}, internal.Page{Cursor: cursor, Limit: limit})
+}
+
+// StreamAll streams the friendships of everyone in a single request, calling fn for each of
+// them until fn returns an error. Over REST the stream is bound by the http client timeout.
+func (c *Client) StreamAll(ctx context.Context, fn func(internal.Friendship) error, types ...internal.RelationType) error {
+	streamer, ok := c.client.(interface {
+		StreamAll(context.Context, internal.EdgeFilter, func(internal.Friendship) error) error
+	})
+	if !ok {
+		return internal.Errorf(internal.EINTERNAL, "streaming isnt supported by the client")
+	}
+	return streamer.StreamAll(ctx, internal.EdgeFilter{Types: types}, fn)
+}
+
+// Import adds the people and friendships of imp at once, edges reference nodes of the import
+// by their external ids. Invalid rows are skipped and reported in the result.
+func (c *Client) Import(ctx context.Context, imp *internal.Import) (internal.ImportResult, error) {
+	res, err := c.client.Import(ctx, imp)
+	if err != nil {
+		return res, err
+	}
+	// rows which couldnt be decoded locally arent sent to the server.
+	if len(imp.Errors) != 0 {
+		res.Errors = append(append([]internal.ImportError(nil), imp.Errors...), res.Errors...)
+	}
+	return res, nil
+}
+
+// ImportFile decodes r in format (json, GraphML or a zip of node/edge CSV files) and imports it.
+func (c *Client) ImportFile(ctx context.Context, format internal.ImportFormat, r io.Reader) (internal.ImportResult, error) {
+	imp, err := importer.Read(r, format)
+	if err != nil {
+		return internal.ImportResult{}, err
+	}
+	return c.Import(ctx, imp)
+}
+
+// Export writes the graph to w in format (GraphML, GEXF, DOT or a zip of node/edge CSV files),
+// only including friendships of the provided types (all types when none are provided).
+func (c *Client) Export(ctx context.Context, format internal.ExportFormat, w io.Writer, types ...internal.RelationType) error {
+	return c.client.Export(ctx, format, internal.EdgeFilter{Types: types}, w)
 }
 
 // AddFriendship creates a new friendship (one-way) between p1 and p2.
@@ -106,6 +276,73 @@ func (c *Client) AddFriendship(ctx context.Context, p1, p2 int64) error {
 	return c.client.AddFriendship(ctx, f)
 }
 
+// AddWeightedFriendship creates a new friendship (one-way) between p1 and p2 with weight as
+// its strength, weight must be positive.
+func (c *Client) AddWeightedFriendship(ctx context.Context, p1, p2 int64, weight float64) error {
+	f := internal.Friendship{
+		P1:      &internal.Person{ID: p1},
+		With:    []int64{p2},
+		Weights: []float64{weight},
+	}
+	return c.client.AddFriendship(ctx, f)
+}
+
+// AddTypedFriendship creates a new friendship (one-way) of type kind between p1 and p2, people
+// can have one friendship of each type between them.
+func (c *Client) AddTypedFriendship(ctx context.Context, p1, p2 int64, kind internal.RelationType) error {
+	f := internal.Friendship{
+		P1:    &internal.Person{ID: p1},
+		With:  []int64{p2},
+		Types: []internal.RelationType{kind},
+	}
+	return c.client.AddFriendship(ctx, f)
+}
+
+// RemoveTypedFriendship removes the friendship (one-way) of type kind between p1 and p2.
+func (c *Client) RemoveTypedFriendship(ctx context.Context, p1, p2 int64, kind internal.RelationType) error {
+	f := internal.Friendship{
+		P1:    &internal.Person{ID: p1},
+		With:  []int64{p2},
+		Types: []internal.RelationType{kind},
+	}
+	return c.client.RemoveFriendship(ctx, f)
+}
+
+// AddMutualFriendship creates a new friendship (both ways) between p1 and p2, both directions
+// are created atomically.
+func (c *Client) AddMutualFriendship(ctx context.Context, p1, p2 int64) error {
+	f := internal.Friendship{
+		P1:     &internal.Person{ID: p1},
+		With:   []int64{p2},
+		Mutual: true,
+	}
+	return c.client.AddFriendship(ctx, f)
+}
+
+// RemoveMutualFriendship removes the friendship (both ways) between p1 and p2.
+func (c *Client) RemoveMutualFriendship(ctx context.Context, p1, p2 int64) error {
+	f := internal.Friendship{
+		P1:     &internal.Person{ID: p1},
+		With:   []int64{p2},
+		Mutual: true,
+	}
+	return c.client.RemoveFriendship(ctx, f)
+}
+
+// GetReciprocity reports whether the friendship between id1 and id2 goes both ways.
+func (c *Client) GetReciprocity(ctx context.Context, id1, id2 int64) (internal.Reciprocity, error) {
+	return c.client.GetReciprocity(ctx, id1, id2)
+}
+
+// RemoveFriendship removes the friendship (one-way) between p1 and p2.
+func (c *Client) RemoveFriendship(ctx context.Context, p1, p2 int64) error {
+	f := internal.Friendship{
+		P1:   &internal.Person{ID: p1},
+		With: []int64{p2},
+	}
+	return c.client.RemoveFriendship(ctx, f)
+}
+
 // AddPerson adds person with name: name and returns the id if successful.
 func (c *Client) AddPerson(ctx context.Context, name string) (int64, error) {
 	p := internal.Person{
@@ -118,6 +355,20 @@ func (c *Client) AddPerson(ctx context.Context, name string) (int64, error) {
 	return p.ID, nil
 }
 
+// AddPersonWithProperties adds person with name: name and custom properties, returns the id
+// if successful.
+func (c *Client) AddPersonWithProperties(ctx context.Context, name string, properties map[string]string) (int64, error) {
+	p := internal.Person{
+		Name:       name,
+		Properties: properties,
+	}
+	if err := c.client.AddPerson(ctx, &p); err != nil {
+		return -1, err
+	}
+
+	return p.ID, nil
+}
+
 // RemovePerson removes person with id: id.
 func (c *Client) RemovePerson(ctx context.Context, id int64) error {
 	return c.client.RemovePerson(ctx, id)
@@ -125,6 +376,45 @@ func (c *Client) RemovePerson(ctx context.Context, id int64) error {
 
 // Listen -----------------------------------------------------------------------------------
 
+// Listen streams the messages with routing keys matching the binding keys (ie: person.* , every
+// message when none are provided) from the relationer server itself rather than the
+// message-broker, over server-sent events or over gRPC when the client uses it.
+//
+// the messages are the ones published to the message-broker from the moment Listen returns,
+// the channel is closed once ctx is done or the server ends the stream (ie: the reciever
+// fell too far behind).
+func (c *Client) Listen(ctx context.Context, keys ...string) (<-chan *Message, error) {
+	watcher, ok := c.client.(interface {
+		Watch(context.Context, ...string) (<-chan internal.Event, error)
+	})
+	if !ok {
+		return nil, internal.Errorf(internal.EINTERNAL, "listening isnt supported by the client")
+	}
+
+	events, err := watcher.Watch(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	recv := make(chan *Message)
+	go func() {
+		defer close(recv)
+		for event := range events {
+			data, err := json.Marshal(event.Payload())
+			if err != nil {
+				continue
+			}
+
+			select {
+			case recv <- &Message{Type: event.Key, Data: data}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return recv, nil
+}
+
 // StartListenDetached will start a separate connection to the message-broker (rabbitmq)
 // and subscribe the recieving channel to it.
 //
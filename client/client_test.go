@@ -1,15 +1,30 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/graph"
+	noop "github.com/Lambels/relationer/internal/no-op"
+	"github.com/Lambels/relationer/internal/pubsub"
+	"github.com/Lambels/relationer/internal/rest"
+	"github.com/Lambels/relationer/internal/rpc"
+	"github.com/Lambels/relationer/internal/service"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 func TestAddPerson(t *testing.T) {
@@ -84,6 +99,963 @@ func TestAddFriendship(t *testing.T) {
 	}
 }
 
+func TestRemoveFriendship(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodDelete; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		var data internal.Friendship
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatal(err)
+		}
+
+		if got, want := data.P1.ID, int64(1); got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := data.With[0], int64(2); got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	if err := c.RemoveFriendship(context.Background(), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetFriendshipTypes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("types"), "colleague,family"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(internal.Friendship{
+			P1:    &internal.Person{ID: 1},
+			With:  []int64{2},
+			Types: []internal.RelationType{internal.Family},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	friendship, err := c.GetFriendship(context.Background(), 1, internal.Family, internal.Colleague)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := friendship.Type(0), internal.Family; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetFriendshipAsOf(t *testing.T) {
+	asOf := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("asOf"), "2023-03-01T12:00:00Z"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("types"), "family"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(internal.Friendship{P1: &internal.Person{ID: 1}, With: []int64{2}})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	friendship, err := c.GetFriendshipAsOf(context.Background(), 1, asOf, internal.Family)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := fmt.Sprint(friendship.With), "[2]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestFindPeople(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query()["where"], []string{"team:infra", "city:berlin"}; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"count": 1,
+			"people": []internal.Person{
+				{ID: 1, Name: "a", Properties: map[string]string{"team": "infra", "city": "berlin"}},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	people, err := c.FindPeople(
+		context.Background(),
+		internal.Property{Key: "team", Value: "infra"},
+		internal.Property{Key: "city", Value: "berlin"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(people), 1; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := people[0].Properties["team"], "infra"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestSearchPeople(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/people/search"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("q"), "alice smith"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"results": []internal.SearchResult{
+				{Person: &internal.Person{ID: 1, Name: "Alice Smith"}, Score: 1},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	results, err := c.SearchPeople(context.Background(), "alice smith", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(results), 1; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := results[0].Person.ID, int64(1); got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("limit"), "2"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		switch cursor := r.URL.Query().Get("cursor"); cursor {
+		case "0":
+			json.NewEncoder(w).Encode(internal.FriendshipPage{
+				Friendships: []internal.Friendship{
+					{P1: &internal.Person{ID: 1}},
+					{P1: &internal.Person{ID: 2}},
+				},
+				NextCursor: 2,
+			})
+		case "2":
+			json.NewEncoder(w).Encode(internal.FriendshipPage{
+				Friendships: []internal.Friendship{
+					{P1: &internal.Person{ID: 3}},
+				},
+			})
+		default:
+			t.Fatalf("unexpected cursor: %v", cursor)
+		}
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	it := c.Iterate(context.Background(), 2)
+	var got []int64
+	for it.Next() {
+		got = append(got, it.Friendship().P1.ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []int64{1, 2, 3}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestStreamAll(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("stream"), "true"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		enc := json.NewEncoder(w)
+		enc.Encode(internal.Friendship{P1: &internal.Person{ID: 1}, With: []int64{2}})
+		enc.Encode(internal.Friendship{P1: &internal.Person{ID: 2}})
+		enc.Encode(map[string]string{"error": "boom"})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	var got []int64
+	err := c.StreamAll(context.Background(), func(f internal.Friendship) error {
+		got = append(got, f.P1.ID)
+		return nil
+	})
+
+	if want := []int64{1, 2}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	// the error line ends the stream.
+	if err == nil {
+		t.Fatal("expected stream error")
+	}
+}
+
+// slowStore is a store paging through 5 people slowly.
+type slowStore struct {
+	service.GraphStore
+}
+
+func (slowStore) GetPage(_ context.Context, _ internal.EdgeFilter, page internal.Page) (internal.FriendshipPage, error) {
+	time.Sleep(60 * time.Millisecond)
+	res := internal.FriendshipPage{
+		Friendships: []internal.Friendship{{P1: &internal.Person{ID: page.Cursor + 1}, With: []int64{}}},
+	}
+	if page.Cursor < 4 {
+		res.NextCursor = page.Cursor + 1
+	}
+	return res, nil
+}
+
+func TestStreamAllWriteTimeout(t *testing.T) {
+	mux := chi.NewRouter()
+	rest.NewHandlerService(slowStore{}, nil, nil).RegisterRouter(mux)
+	ts := httptest.NewUnstartedServer(mux)
+	ts.Config.WriteTimeout = 100 * time.Millisecond // shorter than the whole stream.
+	ts.Start()
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	var got []int64
+	err := c.StreamAll(context.Background(), func(f internal.Friendship) error {
+		got = append(got, f.P1.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{1, 2, 3, 4, 5}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method+" "+r.URL.Path, "POST /batch"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		var ops []internal.BatchOp
+		if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, op := range ops {
+			got = append(got, fmt.Sprintf("%v %v %v %v", op.Op, op.Ref, op.From, op.To))
+		}
+		want := []string{"add_person alice 0 0", "add_friendship  alice 3", "remove_person  0 0"}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(internal.BatchResult{IDs: map[string]int64{"alice": 4}})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	res, err := c.NewBatch().
+		AddPerson("alice", &internal.Person{Name: "Alice"}).
+		AddMutualFriendship(Ref("alice"), ID(3)).
+		RemovePerson(ID(2)).
+		Commit(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := res.IDs["alice"], int64(4); got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestImportFile(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method+" "+r.URL.Path, "POST /import"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		// graphml is decoded by the client and sent as json.
+		var imp internal.Import
+		if err := json.NewDecoder(r.Body).Decode(&imp); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := fmt.Sprintf("%v %v %v", imp.Nodes[0].ID, imp.Nodes[0].Name, imp.Edges[0].Mutual), "n0 Alice true"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(internal.ImportResult{IDs: map[string]int64{"n0": 1, "n1": 2}})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	graphml := `<graphml>
+  <key id="d0" for="node" attr.name="label"/>
+  <graph edgedefault="undirected">
+    <node id="n0"><data key="d0">Alice</data></node>
+    <node id="n1"><data key="d0">Bob</data></node>
+    <edge source="n0" target="n1"/>
+  </graph>
+</graphml>`
+	res, err := c.ImportFile(context.Background(), internal.ImportGraphML, strings.NewReader(graphml))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := res.IDs["n1"], int64(2); got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestExport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/export"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("format"), "dot"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("types"), "family"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		w.Header().Set("Content-Type", internal.DOT.ContentType())
+		w.Write([]byte("digraph relationer {\n}\n"))
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	var buf bytes.Buffer
+	if err := c.Export(context.Background(), internal.DOT, &buf, internal.Family); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := buf.String(), "digraph relationer {\n}\n"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/friendship/path/1/3"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"connected": true,
+			"path": []internal.Person{
+				{ID: 1, Name: "foo"},
+				{ID: 2, Name: "bar"},
+				{ID: 3, Name: "baz"},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	path, err := c.GetPath(context.Background(), 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(path), 3; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := path[1].Name, "bar"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetWeightedPath(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/friendship/distance/1/3"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"connected": true,
+			"distance":  0.5,
+			"path": []internal.Person{
+				{ID: 1, Name: "a"},
+				{ID: 2, Name: "b"},
+				{ID: 3, Name: "c"},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	path, err := c.GetWeightedPath(context.Background(), 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := path.Distance, 0.5; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := len(path.Path), 3; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetPaths(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/friendship/paths/1/3"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("length"), "3"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("limit"), "5"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"paths": [][]internal.Person{
+				{{ID: 1}, {ID: 3}},
+				{{ID: 1}, {ID: 2}, {ID: 3}},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	paths, err := c.GetPaths(context.Background(), 1, 3, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(paths), 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := len(paths[1]), 3; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetNetwork(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/people/1/network"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("depth"), "2"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("edges"), "true"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(internal.Network{
+			Center: &internal.Person{ID: 1},
+			People: []internal.Neighbour{
+				{Person: &internal.Person{ID: 2}, Distance: 1},
+				{Person: &internal.Person{ID: 3}, Distance: 2},
+			},
+			Edges: []internal.Friendship{
+				{P1: &internal.Person{ID: 1}, With: []int64{2}},
+				{P1: &internal.Person{ID: 2}, With: []int64{3}},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	network, err := c.GetNetwork(context.Background(), 1, 2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := network.People[1].Distance, 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := len(network.Edges), 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetMutual(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/friendship/mutual/1/2"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"count":  1,
+			"people": []internal.Person{{ID: 3, Name: "foo"}},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	mutual, err := c.GetMutual(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(mutual), 1; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := mutual[0].Name, "foo"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetRecommendations(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/people/1/recommendations"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("algorithm"), "jaccard"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"algorithm": "jaccard",
+			"recommendations": []internal.Recommendation{
+				{Person: &internal.Person{ID: 3}, Score: 0.5},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	recommendations, err := c.GetRecommendations(context.Background(), 1, 5, internal.Jaccard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := recommendations[0].Score, 0.5; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetClusters(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/clusters"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("kind"), "strong"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind":  "strong",
+			"count": 2,
+			"clusters": []internal.Cluster{
+				{ID: 0, Size: 2, Members: []int64{1, 2}},
+				{ID: 1, Size: 1, Members: []int64{3}},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	clusters, err := c.GetClusters(context.Background(), internal.StrongComponent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(clusters), 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := clusters[0].Size, 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetTopCentrality(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/centrality/top"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("metric"), "betweenness"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("limit"), "2"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"metric": "betweenness",
+			"people": []internal.Ranked{
+				{Person: &internal.Person{ID: 2}, Score: 0.5},
+				{Person: &internal.Person{ID: 1}, Score: 0.1},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	top, err := c.GetTopCentrality(context.Background(), internal.Betweenness, 2, internal.DefaultDamping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := top[0].Person.ID, int64(2); got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetStats(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/stats"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(internal.Stats{People: 3, Friendships: 2, Relationships: map[internal.RelationType]int{internal.Friend: 2}})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	stats, err := c.GetStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := fmt.Sprint(stats.People, stats.Friendships, stats.Relationships), "3 2 map[friend:2]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/query"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+		if r.URL.Query().Get("timeout") == "" {
+			t.Fatalf("Got: no timeout Want: the deadline of ctx")
+		}
+		var req rest.QueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := req.Query, "MATCH (a) RETURN a, a.name"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(internal.QueryResult{
+			Columns: []string{"a", "a.name"},
+			Rows:    [][]internal.QueryValue{{{Person: &internal.Person{ID: 1, Name: "Alice"}}, {Value: "Alice"}}},
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	res, err := c.Query(ctx, "MATCH (a) RETURN a, a.name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := fmt.Sprintf("%v %v", res.Columns, res.Rows), "[a a.name] [[Alice (1) Alice]]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetReciprocity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/friendship/reciprocity/1/2"; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+
+		json.NewEncoder(w).Encode(internal.Reciprocity{
+			P1:           1,
+			P2:           2,
+			Forward:      true,
+			Backward:     false,
+			Reciprocated: false,
+		})
+	}))
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	reciprocity, err := c.GetReciprocity(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := reciprocity.Forward, true; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := reciprocity.Reciprocated, false; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestListen(t *testing.T) {
+	broker := pubsub.New(nil)
+	mux := chi.NewRouter()
+	rest.NewHandlerService(graph.NewGraphStore(nil, &seqStore{}, missCache{}), broker, broker).RegisterRouter(mux)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgs, err := c.Listen(ctx, "person.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	broker.CreatedPerson(ctx, &internal.Person{ID: 1, Name: "foo"})
+	broker.CreatedFriendship(ctx, internal.Friendship{P1: &internal.Person{ID: 1}, With: []int64{2}})
+	broker.DeletedPerson(ctx, 1)
+
+	var got []string
+	for len(got) < 2 {
+		select {
+		case msg := <-msgs:
+			got = append(got, msg.Type+" "+string(msg.Data))
+		case <-time.After(time.Second):
+			t.Fatalf("timed out, got: %v", got)
+		}
+	}
+	if !strings.Contains(got[0], `person.created {"id":1,"name":"foo"`) || got[1] != `person.deleted {"id":1}` {
+		t.Fatalf("Got: %v", got)
+	}
+
+	// the stream ends with ctx.
+	cancel()
+	for range msgs {
+	}
+}
+
+func TestListenWriteTimeout(t *testing.T) {
+	broker := pubsub.New(nil)
+	mux := chi.NewRouter()
+	rest.NewHandlerService(graph.NewGraphStore(nil, &seqStore{}, missCache{}), broker, broker).RegisterRouter(mux)
+	ts := httptest.NewUnstartedServer(mux)
+	ts.Config.WriteTimeout = 100 * time.Millisecond
+	ts.Start()
+	defer ts.Close()
+
+	c := New(&ClientConfig{
+		URL:            ts.URL,
+		Client:         http.DefaultClient,
+		ConsumerConfig: nil,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgs, err := c.Listen(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// events published past the write timeout still reach the stream.
+	time.Sleep(200 * time.Millisecond)
+	broker.DeletedPerson(ctx, 1)
+
+	select {
+	case msg, ok := <-msgs:
+		if !ok {
+			t.Fatal("stream ended by the write timeout")
+		}
+		if got, want := msg.Type+" "+string(msg.Data), `person.deleted {"id":1}`; got != want {
+			t.Fatalf("Got: %v Want: %v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+}
+
+// seqStore is a store generating sequential ids.
+type seqStore struct {
+	noop.NoopStore
+	id int64
+}
+
+func (s *seqStore) AddPerson(_ context.Context, person *internal.Person) error {
+	s.id++
+	person.ID = s.id
+	return nil
+}
+
+// missCache is a cache which never holds any values.
+type missCache struct{}
+
+func (missCache) Set(context.Context, string, interface{}, time.Duration) error { return nil }
+func (missCache) Delete(context.Context, string) error                          { return nil }
+func (missCache) Get(context.Context, string, interface{}) error {
+	return errors.New("cache miss")
+}
+
+func TestGRPC(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	store := graph.NewGraphStore(nil, &seqStore{}, missCache{})
+	broker := pubsub.New(nil)
+	rpc.NewHandlerService(store, broker, broker).Register(srv)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(
+		"bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	c := New(&ClientConfig{Conn: conn})
+	ctx := context.Background()
+	id1, err := c.AddPersonWithProperties(ctx, "foo", map[string]string{"team": "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id2, err := c.AddPerson(ctx, "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddTypedFriendship(ctx, id1, id2, internal.Colleague); err != nil {
+		t.Fatal(err)
+	}
+
+	friendship, err := c.GetFriendship(ctx, id1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(friendship.P1.Properties, friendship.With, friendship.Types), "map[team:a] [2] [colleague]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	var streamed int
+	if err := c.StreamAll(ctx, func(internal.Friendship) error { streamed++; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := streamed, 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	res, err := c.Query(ctx, "MATCH (a)-[r]->(b) RETURN a, r, b.id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(res.Rows), "[[foo (1) 1 -[colleague 1]-> 2 2]]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	_, err = c.GetPerson(ctx, 3)
+	if got, want := internal.ErrorCode(err), internal.ENOTFOUND; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
 func TestCtxCancelDetached(t *testing.T) {
 	c := New(nil)
 	defer c.Close()
@@ -2,7 +2,7 @@ package client
 
 // Message represents a message from the message-broker.
 type Message struct {
-	// The type of the message: person.created , person.deleted , friendship.created
+	// The type of the message: person.created , person.deleted , friendship.created , friendship.deleted
 	Type string
 	// The raw data of the message, encoded to json.
 	Data []byte
@@ -9,32 +9,80 @@ import (
 
 	addfriendship "github.com/Lambels/relationer/cmd/relationer/pkg/add_friendship"
 	addperson "github.com/Lambels/relationer/cmd/relationer/pkg/add_person"
+	"github.com/Lambels/relationer/cmd/relationer/pkg/export"
+	findpeople "github.com/Lambels/relationer/cmd/relationer/pkg/find_people"
+	getcentrality "github.com/Lambels/relationer/cmd/relationer/pkg/get_centrality"
+	getclusters "github.com/Lambels/relationer/cmd/relationer/pkg/get_clusters"
 	getdepth "github.com/Lambels/relationer/cmd/relationer/pkg/get_depth"
+	getdistance "github.com/Lambels/relationer/cmd/relationer/pkg/get_distance"
 	getfriendship "github.com/Lambels/relationer/cmd/relationer/pkg/get_friendship"
+	getmutual "github.com/Lambels/relationer/cmd/relationer/pkg/get_mutual"
+	getnetwork "github.com/Lambels/relationer/cmd/relationer/pkg/get_network"
+	getpath "github.com/Lambels/relationer/cmd/relationer/pkg/get_path"
+	getpaths "github.com/Lambels/relationer/cmd/relationer/pkg/get_paths"
 	getperson "github.com/Lambels/relationer/cmd/relationer/pkg/get_person"
+	getreciprocity "github.com/Lambels/relationer/cmd/relationer/pkg/get_reciprocity"
+	getrecommendations "github.com/Lambels/relationer/cmd/relationer/pkg/get_recommendations"
+	importgraph "github.com/Lambels/relationer/cmd/relationer/pkg/import_graph"
 	"github.com/Lambels/relationer/cmd/relationer/pkg/listen"
+	"github.com/Lambels/relationer/cmd/relationer/pkg/query"
+	removefriendship "github.com/Lambels/relationer/cmd/relationer/pkg/remove_friendship"
 	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/cmd/relationer/pkg/search"
+	"github.com/Lambels/relationer/cmd/relationer/pkg/stats"
 	"github.com/Lambels/relationer/internal/client"
 	"github.com/peterbourgon/ff/v3/ffcli"
 )
 
 func main() {
 	var (
-		rootCmd, rootConf = root.New()
-		createPerson      = addperson.New(rootConf, os.Stdout)
-		createFriendship  = addfriendship.New(rootConf, os.Stdout)
-		getDepth          = getdepth.New(rootConf, os.Stdout)
-		getFriendship     = getfriendship.New(rootConf, os.Stdout)
-		getPerson         = getperson.New(rootConf, os.Stdout)
-		listen            = listen.New(rootConf, os.Stdout)
+		rootCmd, rootConf  = root.New()
+		createPerson       = addperson.New(rootConf, os.Stdout)
+		createFriendship   = addfriendship.New(rootConf, os.Stdout)
+		deleteFriendship   = removefriendship.New(rootConf, os.Stdout)
+		getDepth           = getdepth.New(rootConf, os.Stdout)
+		getFriendship      = getfriendship.New(rootConf, os.Stdout)
+		getPerson          = getperson.New(rootConf, os.Stdout)
+		findPeople         = findpeople.New(rootConf, os.Stdout)
+		searchPeople       = search.New(rootConf, os.Stdout)
+		getPath            = getpath.New(rootConf, os.Stdout)
+		getDistance        = getdistance.New(rootConf, os.Stdout)
+		getPaths           = getpaths.New(rootConf, os.Stdout)
+		getNetwork         = getnetwork.New(rootConf, os.Stdout)
+		getMutual          = getmutual.New(rootConf, os.Stdout)
+		getReciprocity     = getreciprocity.New(rootConf, os.Stdout)
+		getRecommendations = getrecommendations.New(rootConf, os.Stdout)
+		getClusters        = getclusters.New(rootConf, os.Stdout)
+		getCentrality      = getcentrality.New(rootConf, os.Stdout)
+		getStats           = stats.New(rootConf, os.Stdout)
+		exportGraph        = export.New(rootConf, os.Stdout)
+		importGraph        = importgraph.New(rootConf, os.Stdout)
+		runQuery           = query.New(rootConf, os.Stdout)
+		listen             = listen.New(rootConf, os.Stdout)
 	)
 
 	rootCmd.Subcommands = []*ffcli.Command{
 		createPerson,
 		createFriendship,
+		deleteFriendship,
 		getDepth,
 		getFriendship,
 		getPerson,
+		findPeople,
+		searchPeople,
+		getPath,
+		getDistance,
+		getPaths,
+		getNetwork,
+		getMutual,
+		getReciprocity,
+		getRecommendations,
+		getClusters,
+		getCentrality,
+		getStats,
+		exportGraph,
+		importGraph,
+		runQuery,
 		listen,
 	}
 
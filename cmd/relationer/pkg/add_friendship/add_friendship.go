@@ -18,6 +18,8 @@ type Config struct {
 	rootConfig *root.Config
 	out        io.Writer
 	mutual     bool
+	kind       string
+	weight     float64
 }
 
 func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
@@ -28,6 +30,9 @@ func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
 
 	fs := flag.NewFlagSet("relationer add-friendship", flag.ExitOnError)
 	fs.BoolVar(&cfg.mutual, "mutual", false, "create a bi-directional edge")
+	fs.Float64Var(&cfg.weight, "weight", internal.DefaultWeight, "strength of the friendship, must be positive")
+
+	fs.StringVar(&cfg.kind, "type", string(internal.DefaultRelation), "relationship type of the edge to create")
 
 	return &ffcli.Command{
 		Name:       "add-friendship",
@@ -52,21 +57,16 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 	}
 
 	friendship := internal.Friendship{
-		P1:   &internal.Person{ID: int64(id1)},
-		With: []int64{int64(id2)},
+		P1:      &internal.Person{ID: int64(id1)},
+		With:    []int64{int64(id2)},
+		Weights: []float64{c.weight},
+		Types:   []internal.RelationType{internal.RelationType(c.kind)},
+		Mutual:  c.mutual,
 	}
 	start := time.Now()
 	if err := c.rootConfig.Client.AddFriendship(ctx, friendship); err != nil {
 		return err
 	}
-	if c.mutual {
-		p1 := friendship.P1
-		friendship.P1 = &internal.Person{ID: friendship.With[0]}
-		friendship.With[0] = p1.ID
-		if err := c.rootConfig.Client.AddFriendship(ctx, friendship); err != nil {
-			return err
-		}
-	}
 
 	if c.rootConfig.Verbose {
 		fmt.Fprintf(c.out, "created friendship between %v and %v OK\n", id1, id2)
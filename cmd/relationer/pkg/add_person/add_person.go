@@ -3,6 +3,7 @@ package addperson
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"time"
@@ -15,18 +16,31 @@ import (
 type Config struct {
 	rootConfig *root.Config
 	out        io.Writer
+	properties map[string]string
 }
 
 func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
 	cfg := Config{
 		rootConfig: rootConfig,
 		out:        out,
+		properties: make(map[string]string),
 	}
 
+	fs := flag.NewFlagSet("relationer add-person", flag.ExitOnError)
+	fs.Func("prop", "custom property in the key:value form, can be repeated", func(s string) error {
+		prop, err := internal.ParseProperty(s)
+		if err != nil {
+			return err
+		}
+		cfg.properties[prop.Key] = prop.Value
+		return nil
+	})
+
 	return &ffcli.Command{
 		Name:       "add-person",
 		ShortUsage: "relationer add-person",
 		ShortHelp:  "Create a user (node)",
+		FlagSet:    fs,
 		Exec:       cfg.Exec,
 	}
 }
@@ -38,7 +52,8 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 
 	name := args[0]
 	user := &internal.Person{
-		Name: name,
+		Name:       name,
+		Properties: c.properties,
 	}
 	start := time.Now()
 	if err := c.rootConfig.Client.AddPerson(ctx, user); err != nil {
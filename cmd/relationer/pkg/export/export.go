@@ -0,0 +1,80 @@
+package export
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	format     string
+	output     string
+	types      string
+	asOf       string
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer export", flag.ExitOnError)
+	fs.StringVar(&cfg.format, "format", string(internal.GraphML), "export format: graphml, gexf, dot or csv")
+	fs.StringVar(&cfg.output, "o", "", "output file, relationer.<ext> when empty")
+	fs.StringVar(&cfg.types, "types", "", "comma separated relationship types to export, all when empty")
+	fs.StringVar(&cfg.asOf, "as-of", "", "query the graph as it was at this time (RFC3339 or 2006-01-02), now when empty")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "relationer export",
+		ShortHelp:  "Export the graph to a file",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	format := internal.ExportFormat(c.format)
+	if err := format.Validate(); err != nil {
+		return err
+	}
+	filter := root.EdgeFilter(c.types)
+	var err error
+	if filter.AsOf, err = root.AsOf(c.asOf); err != nil {
+		return err
+	}
+	output := c.output
+	if output == "" {
+		output = "relationer" + format.Extension()
+	}
+
+	start := time.Now()
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := c.rootConfig.Client.Export(ctx, format, filter, f); err != nil {
+		os.Remove(output)
+		return err
+	}
+	fmt.Fprintf(c.out, "exported graph to %v\n", output)
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,59 @@
+package findpeople
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	return &ffcli.Command{
+		Name:       "find-people",
+		ShortUsage: "relationer find-people [key:value ...]",
+		ShortHelp:  "Get the people matching every key:value property",
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	where := make([]internal.Property, 0, len(args))
+	for _, arg := range args {
+		prop, err := internal.ParseProperty(arg)
+		if err != nil {
+			return err
+		}
+		where = append(where, prop)
+	}
+
+	start := time.Now()
+	people, err := c.rootConfig.Client.FindPeople(ctx, where)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "%v people found:\n", len(people))
+	for _, person := range people {
+		fmt.Fprintf(c.out, "  ↪%v (%v)\n", person.Name, person.ID)
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
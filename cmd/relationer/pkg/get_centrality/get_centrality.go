@@ -0,0 +1,84 @@
+package getcentrality
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	metric     string
+	limit      int
+	damping    float64
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer get-centrality", flag.ExitOnError)
+	fs.StringVar(&cfg.metric, "metric", string(internal.PageRank), "leaderboard metric: in-degree, out-degree, closeness, betweenness or pagerank")
+	fs.IntVar(&cfg.limit, "limit", 10, "maximum number of people in the leaderboard")
+	fs.Float64Var(&cfg.damping, "damping", internal.DefaultDamping, "pagerank damping factor")
+
+	return &ffcli.Command{
+		Name:       "get-centrality",
+		ShortUsage: "relationer get-centrality [id]",
+		ShortHelp:  "Get the centrality of a person or the top people by a metric",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) > 1 {
+		return errors.New("get-centrality requires at most 1 argument")
+	}
+
+	start := time.Now()
+	if len(args) == 1 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return errors.New("non int argument")
+		}
+
+		centrality, err := c.rootConfig.Client.GetCentrality(ctx, int64(id), c.damping)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "%v (%v):\n", centrality.Person.Name, centrality.Person.ID)
+		fmt.Fprintf(c.out, "  in-degree:   %v\n", centrality.InDegree)
+		fmt.Fprintf(c.out, "  out-degree:  %v\n", centrality.OutDegree)
+		fmt.Fprintf(c.out, "  closeness:   %.4f\n", centrality.Closeness)
+		fmt.Fprintf(c.out, "  betweenness: %.4f\n", centrality.Betweenness)
+		fmt.Fprintf(c.out, "  pagerank:    %.4f\n", centrality.PageRank)
+	} else {
+		top, err := c.rootConfig.Client.GetTopCentrality(ctx, internal.Metric(c.metric), c.limit, c.damping)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "top %v people by %v:\n", len(top), c.metric)
+		for i, ranked := range top {
+			fmt.Fprintf(c.out, "  %v) %v (%v) score: %.4f\n", i+1, ranked.Person.Name, ranked.Person.ID, ranked.Score)
+		}
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package getclusters
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	kind       string
+	members    bool
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer get-clusters", flag.ExitOnError)
+	fs.StringVar(&cfg.kind, "kind", string(internal.WeakComponent), "cluster kind: weak, strong or community")
+	fs.BoolVar(&cfg.members, "members", false, "list the members of each cluster")
+
+	return &ffcli.Command{
+		Name:       "get-clusters",
+		ShortUsage: "relationer get-clusters",
+		ShortHelp:  "Summarise the cluster sizes of the graph",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 0 {
+		return errors.New("get-clusters requires no arguments")
+	}
+
+	start := time.Now()
+	clusters, err := c.rootConfig.Client.GetClusters(ctx, internal.ClusterKind(c.kind))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "%v %v clusters:\n", len(clusters), c.kind)
+	for _, cluster := range clusters {
+		fmt.Fprintf(c.out, "  cluster %v: %v people\n", cluster.ID, cluster.Size)
+		if c.members {
+			for _, member := range cluster.Members {
+				fmt.Fprintf(c.out, "    ↪%v\n", member)
+			}
+		}
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package getdepth
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"strconv"
@@ -15,6 +16,8 @@ import (
 type Config struct {
 	rootConfig *root.Config
 	out        io.Writer
+	types      string
+	asOf       string
 }
 
 func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
@@ -23,10 +26,15 @@ func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
 		out:        out,
 	}
 
+	fs := flag.NewFlagSet("relationer get-depth", flag.ExitOnError)
+	fs.StringVar(&cfg.types, "types", "", "comma separated relationship types to follow, all when empty")
+	fs.StringVar(&cfg.asOf, "as-of", "", "query the graph as it was at this time (RFC3339 or 2006-01-02), now when empty")
+
 	return &ffcli.Command{
 		Name:       "get-depth",
 		ShortUsage: "relationer get-depth",
 		ShortHelp:  "Get depth between 2 nodes",
+		FlagSet:    fs,
 		Exec:       cfg.Exec,
 	}
 }
@@ -44,8 +52,13 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 		return errors.New("non int argument")
 	}
 
+	filter := root.EdgeFilter(c.types)
+	if filter.AsOf, err = root.AsOf(c.asOf); err != nil {
+		return err
+	}
+
 	start := time.Now()
-	depth, err := c.rootConfig.Client.GetDepth(ctx, int64(id1), int64(id2))
+	depth, err := c.rootConfig.Client.GetDepth(ctx, int64(id1), int64(id2), filter)
 	if err != nil {
 		return err
 	}
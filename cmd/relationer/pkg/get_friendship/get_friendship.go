@@ -3,6 +3,7 @@ package getfriendship
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"strconv"
@@ -15,6 +16,8 @@ import (
 type Config struct {
 	rootConfig *root.Config
 	out        io.Writer
+	types      string
+	asOf       string
 }
 
 func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
@@ -23,10 +26,15 @@ func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
 		out:        out,
 	}
 
+	fs := flag.NewFlagSet("relationer get-friendship", flag.ExitOnError)
+	fs.StringVar(&cfg.types, "types", "", "comma separated relationship types to follow, all when empty")
+	fs.StringVar(&cfg.asOf, "as-of", "", "query the graph as it was at this time (RFC3339 or 2006-01-02), now when empty")
+
 	return &ffcli.Command{
 		Name:       "get-friendship",
 		ShortUsage: "relationer get-friendship",
 		ShortHelp:  "Get the relationships of a person",
+		FlagSet:    fs,
 		Exec:       cfg.Exec,
 	}
 }
@@ -40,14 +48,19 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 		return errors.New("non int argument")
 	}
 
+	filter := root.EdgeFilter(c.types)
+	if filter.AsOf, err = root.AsOf(c.asOf); err != nil {
+		return err
+	}
+
 	start := time.Now()
-	friendship, err := c.rootConfig.Client.GetFriendship(ctx, int64(id))
+	friendship, err := c.rootConfig.Client.GetFriendship(ctx, int64(id), filter)
 	if err != nil {
 		return err
 	}
 	fmt.Fprintf(c.out, "%v is friends with:\n", friendship.P1.Name)
-	for _, friend := range friendship.With {
-		fmt.Fprintf(c.out, "  ↪%v\n", friend)
+	for i, friend := range friendship.With {
+		fmt.Fprintf(c.out, "  ↪%v (%v)\n", friend, friendship.Type(i))
 	}
 
 	if c.rootConfig.Verbose {
@@ -0,0 +1,75 @@
+package getnetwork
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	depth      int
+	edges      bool
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer get-network", flag.ExitOnError)
+	fs.IntVar(&cfg.depth, "depth", 1, "maximum number of hops from the person")
+	fs.BoolVar(&cfg.edges, "edges", false, "show the friendships between the people in the network")
+
+	return &ffcli.Command{
+		Name:       "get-network",
+		ShortUsage: "relationer get-network",
+		ShortHelp:  "Get everyone within n hops of a person",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("get-network requires 1 argument")
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+
+	start := time.Now()
+	network, err := c.rootConfig.Client.GetNetwork(ctx, int64(id), c.depth, c.edges)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "%v has %v people within %v hops:\n", network.Center.Name, len(network.People), c.depth)
+	for _, neighbour := range network.People {
+		fmt.Fprintf(c.out, "  ↪%v (%v) at %v hops\n", neighbour.Person.Name, neighbour.Person.ID, neighbour.Distance)
+	}
+	if c.edges {
+		fmt.Fprintln(c.out, "friendships:")
+		for _, friendship := range network.Edges {
+			for _, friend := range friendship.With {
+				fmt.Fprintf(c.out, "  %v -> %v\n", friendship.P1.ID, friend)
+			}
+		}
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
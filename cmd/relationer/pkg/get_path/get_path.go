@@ -0,0 +1,69 @@
+package getpath
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	return &ffcli.Command{
+		Name:       "get-path",
+		ShortUsage: "relationer get-path",
+		ShortHelp:  "Get the shortest path between 2 nodes",
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errors.New("get-path requires 2 argument")
+	}
+	id1, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+	id2, err := strconv.Atoi(args[1])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+
+	start := time.Now()
+	path, err := c.rootConfig.Client.GetPath(ctx, int64(id1), int64(id2))
+	if err != nil {
+		return err
+	}
+	if len(path) == 0 {
+		fmt.Fprintf(c.out, "%v and %v arent connected\n", id1, id2)
+	}
+	for i, person := range path {
+		if i == 0 {
+			fmt.Fprintf(c.out, "%v (%v)\n", person.Name, person.ID)
+			continue
+		}
+		fmt.Fprintf(c.out, "  ↪%v (%v)\n", person.Name, person.ID)
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
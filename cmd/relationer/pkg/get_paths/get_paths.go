@@ -0,0 +1,77 @@
+package getpaths
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	length     int
+	limit      int
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer get-paths", flag.ExitOnError)
+	fs.IntVar(&cfg.length, "length", 4, "maximum number of hops in a path (at most 6)")
+	fs.IntVar(&cfg.limit, "limit", 10, "maximum number of paths (at most 1000)")
+
+	return &ffcli.Command{
+		Name:       "get-paths",
+		ShortUsage: "relationer get-paths",
+		ShortHelp:  "Get the simple paths between 2 nodes",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errors.New("get-paths requires 2 argument")
+	}
+	id1, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+	id2, err := strconv.Atoi(args[1])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+
+	start := time.Now()
+	paths, err := c.rootConfig.Client.GetPaths(ctx, int64(id1), int64(id2), c.length, c.limit)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		fmt.Fprintf(c.out, "%v and %v arent connected in %v hops\n", id1, id2, c.length)
+	}
+	for i, path := range paths {
+		fmt.Fprintf(c.out, "%v)", i+1)
+		for _, person := range path {
+			fmt.Fprintf(c.out, " %v (%v)", person.Name, person.ID)
+		}
+		fmt.Fprintln(c.out)
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
@@ -46,6 +46,9 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 		return err
 	}
 	fmt.Fprintf(c.out, "Id: %v | Name: %v | Created At: %v\n", person.ID, person.Name, person.CreatedAt)
+	for key, value := range person.Properties {
+		fmt.Fprintf(c.out, "  %v: %v\n", key, value)
+	}
 
 	if c.rootConfig.Verbose {
 		fmt.Fprintf(c.out, "OK\n")
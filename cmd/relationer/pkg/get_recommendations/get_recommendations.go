@@ -0,0 +1,68 @@
+package getrecommendations
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	limit      int
+	algorithm  string
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer get-recommendations", flag.ExitOnError)
+	fs.IntVar(&cfg.limit, "limit", 10, "maximum number of recommendations")
+	fs.StringVar(&cfg.algorithm, "algorithm", string(internal.CommonNeighbours), "scoring algorithm: common, jaccard or adamic-adar")
+
+	return &ffcli.Command{
+		Name:       "get-recommendations",
+		ShortUsage: "relationer get-recommendations",
+		ShortHelp:  "Get the people a person may know",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("get-recommendations requires 1 argument")
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+
+	start := time.Now()
+	recommendations, err := c.rootConfig.Client.GetRecommendations(ctx, int64(id), c.limit, internal.Algorithm(c.algorithm))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "%v may know:\n", id)
+	for _, recommendation := range recommendations {
+		fmt.Fprintf(c.out, "  ↪%v (%v) score: %.3f\n", recommendation.Person.Name, recommendation.Person.ID, recommendation.Score)
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package importgraph
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/importer"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	format     string
+	nodes      string
+	edges      string
+	ids        bool
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer import", flag.ExitOnError)
+	fs.StringVar(&cfg.format, "format", "", "import format: json, graphml or csv (zip), guessed from the file extension when empty")
+	fs.StringVar(&cfg.nodes, "nodes", "", "nodes csv file, used with -edges instead of a file argument")
+	fs.StringVar(&cfg.edges, "edges", "", "edges csv file, used with -nodes instead of a file argument")
+	fs.BoolVar(&cfg.ids, "ids", false, "show the ids generated for each node")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "relationer import",
+		ShortHelp:  "Import people and friendships from a file",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	start := time.Now()
+	imp, err := c.read(args)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.rootConfig.Client.Import(ctx, imp)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "imported %v people and %v friendships\n", len(res.People), len(res.Friendships))
+	if len(res.Errors) != 0 {
+		fmt.Fprintf(c.out, "%v rows skipped:\n", len(res.Errors))
+		for _, rowErr := range res.Errors {
+			fmt.Fprintf(c.out, "  %v %v: %v\n", rowErr.Kind, rowErr.Row, rowErr.Error)
+		}
+	}
+	if c.ids {
+		refs := make([]string, 0, len(res.IDs))
+		for ref := range res.IDs {
+			refs = append(refs, ref)
+		}
+		sort.Strings(refs)
+		for _, ref := range refs {
+			fmt.Fprintf(c.out, "  %v -> %v\n", ref, res.IDs[ref])
+		}
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
+
+// read decodes the import from the file argument or the -nodes and -edges csv files.
+func (c *Config) read(args []string) (*internal.Import, error) {
+	if c.nodes != "" || c.edges != "" {
+		if c.nodes == "" || c.edges == "" || len(args) != 0 {
+			return nil, errors.New("import requires both -nodes and -edges and no argument")
+		}
+		nodes, err := os.Open(c.nodes)
+		if err != nil {
+			return nil, err
+		}
+		defer nodes.Close()
+		edges, err := os.Open(c.edges)
+		if err != nil {
+			return nil, err
+		}
+		defer edges.Close()
+
+		return importer.ReadCSV(nodes, edges)
+	}
+
+	if len(args) != 1 {
+		return nil, errors.New("import requires 1 argument")
+	}
+	format := internal.ImportFormat(c.format)
+	if format == "" {
+		switch ext := strings.ToLower(filepath.Ext(args[0])); ext {
+		case ".zip":
+			format = internal.ImportCSV
+		case ".xml":
+			format = internal.ImportGraphML
+		case ".csv":
+			return nil, errors.New("csv files are imported with -nodes and -edges")
+		default:
+			format = internal.ImportFormat(strings.TrimPrefix(ext, "."))
+		}
+	}
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return importer.Read(f, format)
+}
@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	timeout    time.Duration
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer query", flag.ExitOnError)
+	fs.DurationVar(&cfg.timeout, "timeout", 0, "stop the query after the timeout, the server default when 0")
+
+	return &ffcli.Command{
+		Name:       "query",
+		ShortUsage: "relationer query [-timeout 2s] 'MATCH (a {name: \"Alice\"})-[:friend*1..2]->(b) RETURN b LIMIT 10'",
+		ShortHelp:  "Run a query written in the query language",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("query requires at least 1 argument")
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	res, err := c.rootConfig.Client.Query(ctx, strings.Join(args, " "))
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(res.Columns, "\t"))
+	for _, row := range res.Rows {
+		values := make([]string, len(row))
+		for i, value := range row {
+			values[i] = value.String()
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	w.Flush()
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
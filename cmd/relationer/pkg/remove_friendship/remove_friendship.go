@@ -0,0 +1,77 @@
+package removefriendship
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	mutual     bool
+	kind       string
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer remove-friendship", flag.ExitOnError)
+	fs.BoolVar(&cfg.mutual, "mutual", false, "remove the bi-directional edge")
+
+	fs.StringVar(&cfg.kind, "type", string(internal.DefaultRelation), "relationship type of the edge to remove")
+
+	return &ffcli.Command{
+		Name:       "remove-friendship",
+		ShortUsage: "relationer remove-friendship",
+		ShortHelp:  "Delete a friendship (edge) uni-directional from id1 -> id2",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errors.New("remove-friendship requires 2 argument")
+	}
+	id1, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+	id2, err := strconv.Atoi(args[1])
+	if err != nil {
+		return errors.New("non int argument")
+	}
+
+	friendship := internal.Friendship{
+		P1:     &internal.Person{ID: int64(id1)},
+		With:   []int64{int64(id2)},
+		Types:  []internal.RelationType{internal.RelationType(c.kind)},
+		Mutual: c.mutual,
+	}
+	start := time.Now()
+	if err := c.rootConfig.Client.RemoveFriendship(ctx, friendship); err != nil {
+		return err
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "removed friendship between %v and %v OK\n", id1, id2)
+		if c.mutual {
+			fmt.Fprintf(c.out, "removed friendship between %v and %v OK\n", id2, id1)
+		}
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
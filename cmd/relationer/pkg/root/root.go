@@ -2,8 +2,12 @@ package root
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"strings"
+	"time"
 
+	"github.com/Lambels/relationer/internal"
 	"github.com/Lambels/relationer/internal/service"
 	"github.com/peterbourgon/ff/v3/ffcli"
 )
@@ -40,6 +44,34 @@ func New() (*ffcli.Command, *Config) {
 	}, &cfg
 }
 
+// EdgeFilter parses a comma separated list of relationship types to a filter, an empty list
+// allows all types.
+func EdgeFilter(types string) internal.EdgeFilter {
+	var filter internal.EdgeFilter
+	for _, t := range strings.Split(types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			filter.Types = append(filter.Types, internal.RelationType(t))
+		}
+	}
+	return filter
+}
+
+// AsOf parses a timestamp in the RFC3339 or 2006-01-02 (UTC midnight) form, an empty
+// timestamp is the zero time meaning now.
+func AsOf(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.New("invalid as-of time, expected RFC3339 or 2006-01-02")
+	}
+	return t, nil
+}
+
 func parseError(err error, message string) error {
 	return nil
 }
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	limit      int
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer search", flag.ExitOnError)
+	fs.IntVar(&cfg.limit, "limit", 10, "maximum number of results")
+
+	return &ffcli.Command{
+		Name:       "search",
+		ShortUsage: "relationer search [name ...]",
+		ShortHelp:  "Search people by name (prefix and typo tolerant)",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("search requires at least 1 argument")
+	}
+
+	start := time.Now()
+	results, err := c.rootConfig.Client.SearchPeople(ctx, strings.Join(args, " "), c.limit)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		fmt.Fprintln(c.out, "no people found")
+	}
+	for i, result := range results {
+		fmt.Fprintf(c.out, "%v) %v (%v) score: %.3f\n", i+1, result.Person.Name, result.Person.ID, result.Score)
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/Lambels/relationer/cmd/relationer/pkg/root"
+	"github.com/Lambels/relationer/internal"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+type Config struct {
+	rootConfig *root.Config
+	out        io.Writer
+	degrees    bool
+}
+
+func New(rootConfig *root.Config, out io.Writer) *ffcli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("relationer stats", flag.ExitOnError)
+	fs.BoolVar(&cfg.degrees, "degrees", false, "show the in and out degree distributions")
+
+	return &ffcli.Command{
+		Name:       "stats",
+		ShortUsage: "relationer stats",
+		ShortHelp:  "Get graph-wide statistics",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 0 {
+		return errors.New("stats requires no arguments")
+	}
+
+	start := time.Now()
+	stats, err := c.rootConfig.Client.GetStats(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "people:            %v (%v isolated)\n", stats.People, stats.Isolated)
+	fmt.Fprintf(c.out, "friendships:       %v\n", stats.Friendships)
+	kinds := make([]string, 0, len(stats.Relationships))
+	for kind := range stats.Relationships {
+		kinds = append(kinds, string(kind))
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		fmt.Fprintf(c.out, "  ↪%v: %v\n", kind, stats.Relationships[internal.RelationType(kind)])
+	}
+	fmt.Fprintf(c.out, "density:           %.6f\n", stats.Density)
+	fmt.Fprintf(c.out, "mean degree:       %.3f\n", stats.MeanDegree)
+	fmt.Fprintf(c.out, "components:        %v weak, %v strong (largest: %v people)\n", stats.Components, stats.StrongComponents, stats.LargestComponent)
+	estimate := ""
+	if stats.Estimated {
+		estimate = " (estimated)"
+	}
+	fmt.Fprintf(c.out, "diameter:          %v%v\n", stats.Diameter, estimate)
+	fmt.Fprintf(c.out, "radius:            %v%v\n", stats.Radius, estimate)
+	fmt.Fprintf(c.out, "clustering:        %.4f\n", stats.Clustering)
+	if c.degrees {
+		c.printDegrees("in-degree", stats.InDegrees)
+		c.printDegrees("out-degree", stats.OutDegrees)
+	}
+
+	if c.rootConfig.Verbose {
+		fmt.Fprintf(c.out, "OK\n")
+		fmt.Fprintf(c.out, "Process took %v \n", time.Since(start))
+	}
+
+	return nil
+}
+
+func (c *Config) printDegrees(name string, degrees []internal.DegreeCount) {
+	fmt.Fprintf(c.out, "%v distribution:\n", name)
+	for _, bucket := range degrees {
+		fmt.Fprintf(c.out, "  %4v: %v\n", bucket.Degree, bucket.People)
+	}
+}
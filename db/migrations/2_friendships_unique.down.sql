@@ -0,0 +1 @@
+ALTER TABLE friendships DROP CONSTRAINT friendships_pkey;
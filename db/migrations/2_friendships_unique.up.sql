@@ -0,0 +1,10 @@
+BEGIN;
+
+DELETE FROM friendships a USING friendships b
+WHERE a.ctid < b.ctid
+    AND a.person1_id = b.person1_id
+    AND a.person2_id = b.person2_id;
+
+ALTER TABLE friendships ADD PRIMARY KEY (person1_id, person2_id);
+
+COMMIT;
@@ -0,0 +1 @@
+ALTER TABLE friendships DROP COLUMN weight;
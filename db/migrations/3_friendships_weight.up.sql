@@ -0,0 +1 @@
+ALTER TABLE friendships ADD COLUMN weight double precision NOT NULL DEFAULT 1 CHECK (weight > 0);
@@ -0,0 +1,10 @@
+BEGIN;
+
+DELETE FROM friendships WHERE type <> 'friend';
+
+ALTER TABLE friendships DROP CONSTRAINT friendships_pkey;
+ALTER TABLE friendships ADD PRIMARY KEY (person1_id, person2_id);
+
+ALTER TABLE friendships DROP COLUMN type;
+
+COMMIT;
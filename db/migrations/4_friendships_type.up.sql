@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE friendships ADD COLUMN type text NOT NULL DEFAULT 'friend';
+
+ALTER TABLE friendships DROP CONSTRAINT friendships_pkey;
+ALTER TABLE friendships ADD PRIMARY KEY (person1_id, person2_id, type);
+
+COMMIT;
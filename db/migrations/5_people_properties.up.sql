@@ -0,0 +1 @@
+ALTER TABLE people ADD COLUMN properties jsonb NOT NULL DEFAULT '{}';
@@ -0,0 +1,18 @@
+BEGIN;
+
+DELETE FROM friendships WHERE ended_at IS NOT NULL;
+DELETE FROM people WHERE ended_at IS NOT NULL;
+
+DROP INDEX friendships_current;
+ALTER TABLE friendships DROP CONSTRAINT friendships_pkey;
+ALTER TABLE friendships ADD PRIMARY KEY (person1_id, person2_id, type);
+
+ALTER TABLE friendships DROP COLUMN ended_at;
+ALTER TABLE friendships DROP COLUMN created_at;
+ALTER TABLE friendships DROP COLUMN id;
+
+ALTER TABLE people DROP COLUMN ended_at;
+ALTER TABLE people ALTER COLUMN created_at TYPE date;
+ALTER TABLE people ALTER COLUMN created_at SET DEFAULT CURRENT_DATE;
+
+COMMIT;
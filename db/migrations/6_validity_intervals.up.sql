@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE people ALTER COLUMN created_at TYPE timestamptz;
+ALTER TABLE people ALTER COLUMN created_at SET DEFAULT now();
+ALTER TABLE people ADD COLUMN ended_at timestamptz;
+
+ALTER TABLE friendships ADD COLUMN id bigserial;
+ALTER TABLE friendships ADD COLUMN created_at timestamptz NOT NULL DEFAULT now();
+ALTER TABLE friendships ADD COLUMN ended_at timestamptz;
+
+-- ended friendships are kept, only one current friendship of a type can exist.
+ALTER TABLE friendships DROP CONSTRAINT friendships_pkey;
+ALTER TABLE friendships ADD PRIMARY KEY (id);
+CREATE UNIQUE INDEX friendships_current ON friendships (person1_id, person2_id, type) WHERE ended_at IS NULL;
+
+COMMIT;
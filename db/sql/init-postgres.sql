@@ -1,10 +1,20 @@
 CREATE TABLE people (
     id serial PRIMARY KEY,
     name text NOT NULL,
-    created_at date NOT NULL DEFAULT CURRENT_DATE
+    created_at timestamptz NOT NULL DEFAULT now(),
+    ended_at timestamptz,
+    properties jsonb NOT NULL DEFAULT '{}'
 );
 
 CREATE TABLE friendships (
+    id bigserial PRIMARY KEY,
     person1_id int REFERENCES people (id) ON DELETE CASCADE,
-    person2_id int REFERENCES people (id) ON DELETE CASCADE
-);
\ No newline at end of file
+    person2_id int REFERENCES people (id) ON DELETE CASCADE,
+    weight double precision NOT NULL DEFAULT 1 CHECK (weight > 0),
+    type text NOT NULL DEFAULT 'friend',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    ended_at timestamptz
+);
+
+-- ended friendships are kept, only one current friendship of a type can exist.
+CREATE UNIQUE INDEX friendships_current ON friendships (person1_id, person2_id, type) WHERE ended_at IS NULL;
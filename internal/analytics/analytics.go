@@ -0,0 +1,247 @@
+// Package analytics computes centrality metrics over a snapshot of the graph.
+package analytics
+
+import (
+	"context"
+	"math"
+	"math/rand"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+const (
+	// pageRankIterations bounds the power iterations of PageRank.
+	pageRankIterations = 100
+	// pageRankTolerance is the L1 change under which PageRank is considered converged.
+	pageRankTolerance = 1e-9
+)
+
+// Graph is a read only snapshot of the directed graph.
+type Graph struct {
+	Nodes []int64
+	Edges map[int64][]int64
+}
+
+// NewGraph builds a snapshot from nodes and edges, edges to unknown people and duplicate
+// edges are dropped.
+func NewGraph(nodes []int64, edges map[int64][]int64) *Graph {
+	g := &Graph{
+		Nodes: nodes,
+		Edges: make(map[int64][]int64, len(edges)),
+	}
+
+	known := make(map[int64]bool, len(nodes))
+	for _, id := range nodes {
+		known[id] = true
+	}
+	for _, id := range nodes {
+		seen := make(map[int64]bool, len(edges[id]))
+		for _, j := range edges[id] {
+			if !known[j] || seen[j] {
+				continue
+			}
+			seen[j] = true
+			g.Edges[id] = append(g.Edges[id], j)
+		}
+	}
+	return g
+}
+
+// Degrees returns the in and out degree of every person.
+func Degrees(g *Graph) (map[int64]int, map[int64]int) {
+	in := make(map[int64]int, len(g.Nodes))
+	out := make(map[int64]int, len(g.Nodes))
+	for _, id := range g.Nodes {
+		out[id] = len(g.Edges[id])
+		for _, j := range g.Edges[id] {
+			in[j]++
+		}
+	}
+	return in, out
+}
+
+// Closeness returns the closeness of every person following friendship direction.
+//
+// uses the Wasserman and Faust formula so people who reach only part of the graph are
+// scored relative to the part they reach.
+func Closeness(ctx context.Context, g *Graph) (map[int64]float64, error) {
+	res := make(map[int64]float64, len(g.Nodes))
+	for _, source := range g.Nodes {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		res[source] = ClosenessOf(g, source)
+	}
+	return res, nil
+}
+
+// ClosenessOf returns the closeness of the person with id, computed with a single bfs.
+func ClosenessOf(g *Graph, id int64) float64 {
+	n := len(g.Nodes)
+	if n < 2 {
+		return 0
+	}
+
+	distances := bfs(g, id)
+	var total int
+	for _, d := range distances {
+		total += d
+	}
+	if total == 0 {
+		return 0
+	}
+
+	reached := len(distances) - 1 // exclude source.
+	return (float64(reached) / float64(n-1)) * (float64(reached) / float64(total))
+}
+
+// Betweenness returns the normalized betweenness of every person using Brandes algorithm.
+func Betweenness(ctx context.Context, g *Graph) (map[int64]float64, error) {
+	return betweenness(ctx, g, g.Nodes)
+}
+
+// SampledBetweenness estimates the normalized betweenness of every person running Brandes
+// algorithm from samples sources picked at random with seed, scaling their dependencies by
+// the share of sources left out. Exact when samples covers every person.
+func SampledBetweenness(ctx context.Context, g *Graph, samples int, seed int64) (map[int64]float64, error) {
+	if samples >= len(g.Nodes) {
+		return Betweenness(ctx, g)
+	}
+
+	sources := make([]int64, len(g.Nodes))
+	copy(sources, g.Nodes)
+	rnd := rand.New(rand.NewSource(seed))
+	rnd.Shuffle(len(sources), func(i, j int) { sources[i], sources[j] = sources[j], sources[i] })
+	return betweenness(ctx, g, sources[:samples])
+}
+
+// betweenness accumulates the dependencies of the shortest paths from sources, scaled up to
+// the whole graph when sources are only part of it.
+func betweenness(ctx context.Context, g *Graph, sources []int64) (map[int64]float64, error) {
+	res := make(map[int64]float64, len(g.Nodes))
+	for _, id := range g.Nodes {
+		res[id] = 0
+	}
+
+	for _, source := range sources {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		stack := make([]int64, 0)
+		preds := make(map[int64][]int64)
+		sigma := map[int64]float64{source: 1}
+		distances := map[int64]int{source: 0}
+
+		queue := []int64{source}
+		for len(queue) != 0 {
+			v := queue[0]
+			queue = queue[1:]
+			stack = append(stack, v)
+
+			for _, w := range g.Edges[v] {
+				if _, ok := distances[w]; !ok {
+					distances[w] = distances[v] + 1
+					queue = append(queue, w)
+				}
+				if distances[w] == distances[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+
+		delta := make(map[int64]float64, len(stack))
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != source {
+				res[w] += delta[w]
+			}
+		}
+	}
+
+	if n := len(g.Nodes); n > 2 && len(sources) != 0 {
+		scale := float64(n) / float64(len(sources)) / float64((n-1)*(n-2))
+		for id := range res {
+			res[id] *= scale
+		}
+	}
+	return res, nil
+}
+
+// PageRank returns the PageRank of every person, people without friendships spread their
+// rank evenly over everyone.
+func PageRank(ctx context.Context, g *Graph, damping float64) (map[int64]float64, error) {
+	if damping <= 0 || damping >= 1 {
+		return nil, internal.Errorf(internal.EINVALID, "damping must be between 0 and 1")
+	}
+
+	n := float64(len(g.Nodes))
+	ranks := make(map[int64]float64, len(g.Nodes))
+	for _, id := range g.Nodes {
+		ranks[id] = 1 / n
+	}
+
+	for i := 0; i < pageRankIterations; i++ {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		var dangling float64
+		for _, id := range g.Nodes {
+			if len(g.Edges[id]) == 0 {
+				dangling += ranks[id]
+			}
+		}
+
+		base := (1-damping)/n + damping*dangling/n
+		next := make(map[int64]float64, len(g.Nodes))
+		for _, id := range g.Nodes {
+			next[id] += base
+			friends := g.Edges[id]
+			for _, j := range friends {
+				next[j] += damping * ranks[id] / float64(len(friends))
+			}
+		}
+
+		var change float64
+		for _, id := range g.Nodes {
+			change += math.Abs(next[id] - ranks[id])
+		}
+		ranks = next
+		if change < pageRankTolerance {
+			break
+		}
+	}
+	return ranks, nil
+}
+
+// bfs returns the hop distance from source to every person it reaches, source included.
+func bfs(g *Graph, source int64) map[int64]int {
+	distances := map[int64]int{source: 0}
+	queue := []int64{source}
+	for len(queue) != 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, w := range g.Edges[v] {
+			if _, ok := distances[w]; !ok {
+				distances[w] = distances[v] + 1
+				queue = append(queue, w)
+			}
+		}
+	}
+	return distances
+}
@@ -0,0 +1,157 @@
+package analytics
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+// star links the center 1 both ways with each of the leaves 2 to n.
+func star(n int) *Graph {
+	nodes := []int64{1}
+	edges := make(map[int64][]int64)
+	for i := int64(2); i <= int64(n); i++ {
+		nodes = append(nodes, i)
+		edges[1] = append(edges[1], i)
+		edges[i] = []int64{1}
+	}
+	return NewGraph(nodes, edges)
+}
+
+// path links 1 -> 2 -> ... -> n.
+func path(n int) *Graph {
+	nodes := make([]int64, 0, n)
+	edges := make(map[int64][]int64)
+	for i := int64(1); i <= int64(n); i++ {
+		nodes = append(nodes, i)
+		if i < int64(n) {
+			edges[i] = []int64{i + 1}
+		}
+	}
+	return NewGraph(nodes, edges)
+}
+
+// equal fails the test if the scores of the people in want differ from got.
+func equal(t *testing.T, name string, got map[int64]float64, want map[int64]float64) {
+	t.Helper()
+	for id, score := range want {
+		if math.Abs(got[id]-score) > 1e-6 {
+			t.Fatalf("%v(%v): Got: %v Want: %v", name, id, got[id], score)
+		}
+	}
+}
+
+func TestNewGraph(t *testing.T) {
+	g := NewGraph([]int64{1, 2}, map[int64][]int64{1: {2, 2, 3}, 3: {1}})
+	if got, want := len(g.Edges[1]), 1; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if _, ok := g.Edges[3]; ok {
+		t.Fatal("expected edges of unknown people to be dropped")
+	}
+}
+
+func TestDegrees(t *testing.T) {
+	in, out := Degrees(star(5))
+	if in[1] != 4 || out[1] != 4 {
+		t.Fatalf("Got: %v %v Want: 4 4", in[1], out[1])
+	}
+	if in[2] != 1 || out[2] != 1 {
+		t.Fatalf("Got: %v %v Want: 1 1", in[2], out[2])
+	}
+}
+
+func TestCloseness(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		g    *Graph
+		want map[int64]float64
+	}{
+		// the center reaches everyone in 1 hop, leaves reach the other leaves in 2.
+		{"star", star(5), map[int64]float64{1: 1, 2: 4.0 / 7, 5: 4.0 / 7}},
+		// only part of the path is reached following direction.
+		{"path", path(4), map[int64]float64{1: 0.5, 2: 4.0 / 9, 3: 1.0 / 3, 4: 0}},
+	} {
+		closeness, err := Closeness(context.Background(), test.g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equal(t, test.name, closeness, test.want)
+
+		for id, score := range test.want {
+			if got := ClosenessOf(test.g, id); math.Abs(got-score) > 1e-6 {
+				t.Fatalf("%v(%v): Got: %v Want: %v", test.name, id, got, score)
+			}
+		}
+	}
+}
+
+func TestBetweenness(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		g    *Graph
+		want map[int64]float64
+	}{
+		// every path between two leaves goes through the center.
+		{"star", star(5), map[int64]float64{1: 1, 2: 0, 5: 0}},
+		// 2 lies on 1 -> 3 and 1 -> 4, 3 on 1 -> 4 and 2 -> 4, out of the 6 ordered pairs.
+		{"path", path(4), map[int64]float64{1: 0, 2: 1.0 / 3, 3: 1.0 / 3, 4: 0}},
+	} {
+		betweenness, err := Betweenness(context.Background(), test.g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equal(t, test.name, betweenness, test.want)
+
+		// sampling every source is exact.
+		sampled, err := SampledBetweenness(context.Background(), test.g, len(test.g.Nodes), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equal(t, test.name, sampled, test.want)
+	}
+
+	// leaves lie on no shortest path whichever sources are sampled, the center on all of them.
+	sampled, err := SampledBetweenness(context.Background(), star(9), 4, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sampled[1]; got <= 0 {
+		t.Fatalf("Got: %v Want: > 0", got)
+	}
+	for id := int64(2); id <= 9; id++ {
+		if got := sampled[id]; got != 0 {
+			t.Fatalf("%v: Got: %v Want: 0", id, got)
+		}
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	ranks, err := PageRank(context.Background(), star(5), 0.85)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// c = 0.15/5 + 0.85*4r and r = 0.15/5 + 0.85*c/4 for the center c and each leaf r.
+	center := 0.132 / 0.2775
+	equal(t, "star", ranks, map[int64]float64{1: center, 2: (1 - center) / 4, 5: (1 - center) / 4})
+
+	// rank flows down the path and sums up to 1.
+	ranks, err = PageRank(context.Background(), path(4), 0.85)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var total float64
+	for id := int64(1); id <= 4; id++ {
+		total += ranks[id]
+		if id > 1 && ranks[id] <= ranks[id-1] {
+			t.Fatalf("expected rank of %v: %v to exceed rank of %v: %v", id, ranks[id], id-1, ranks[id-1])
+		}
+	}
+	if math.Abs(total-1) > 1e-6 {
+		t.Fatalf("Got: %v Want: 1", total)
+	}
+
+	if _, err := PageRank(context.Background(), path(4), 1); err == nil {
+		t.Fatal("expected invalid damping error")
+	}
+}
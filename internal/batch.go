@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+)
+
+// BatchOpKind represents the kind of a batch operation.
+type BatchOpKind string
+
+// Batch operation kinds.
+const (
+	AddPersonOp        BatchOpKind = "add_person"
+	AddFriendshipOp    BatchOpKind = "add_friendship"
+	RemoveFriendshipOp BatchOpKind = "remove_friendship"
+	RemovePersonOp     BatchOpKind = "remove_person"
+)
+
+// PersonRef references a person in a batch, either an existing person by ID or a person
+// created earlier in the same batch by Ref.
+type PersonRef struct {
+	ID  int64  `json:"id,omitempty"`
+	Ref string `json:"ref,omitempty"`
+}
+
+func (r PersonRef) String() string {
+	if r.Ref != "" {
+		return r.Ref
+	}
+	return fmt.Sprint(r.ID)
+}
+
+// BatchOp is a single operation of a batch, the fields used depend on the kind:
+//
+// add_person: Person, optionally named by Ref for later operations.
+//
+// add_friendship, remove_friendship: From, To and optionally Weight, Type and Mutual.
+//
+// remove_person: Target.
+type BatchOp struct {
+	Op     BatchOpKind  `json:"op"`
+	Ref    string       `json:"ref,omitempty"`
+	Person *Person      `json:"person,omitempty"`
+	From   PersonRef    `json:"from,omitempty"`
+	To     PersonRef    `json:"to,omitempty"`
+	Weight float64      `json:"weight,omitempty"`
+	Type   RelationType `json:"type,omitempty"`
+	Mutual bool         `json:"mutual,omitempty"`
+	Target PersonRef    `json:"target,omitempty"`
+}
+
+// Mutation is a resolved batch operation, people created earlier in the batch are shared by
+// pointer so their ids are read once stored.
+type Mutation struct {
+	Op     BatchOpKind
+	Person *Person   // add_person and remove_person.
+	Link   Link      // add_friendship and remove_friendship.
+	At     time.Time // set by stores recording when the mutation was stored.
+}
+
+// Result returns the outcome of the mutation, should be called once the batch is stored.
+func (m Mutation) Result() BatchOpResult {
+	res := BatchOpResult{Op: m.Op}
+	switch m.Op {
+	case AddPersonOp, RemovePersonOp:
+		res.Person = m.Person
+	case AddFriendshipOp, RemoveFriendshipOp:
+		friendship := m.Link.Friendship()
+		res.Friendship = &friendship
+	}
+	return res
+}
+
+// BatchOpResult is the outcome of a batch operation, holding the person or friendship added
+// or removed.
+type BatchOpResult struct {
+	Op         BatchOpKind `json:"op"`
+	Person     *Person     `json:"person,omitempty"`
+	Friendship *Friendship `json:"friendship,omitempty"`
+}
+
+// BatchResult holds the outcome of each operation of a batch, in order.
+type BatchResult struct {
+	Results []BatchOpResult  `json:"results"`
+	IDs     map[string]int64 `json:"ids"` // ref to the id of the person created.
+}
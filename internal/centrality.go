@@ -0,0 +1,40 @@
+package internal
+
+// Metric represents a centrality metric.
+type Metric string
+
+// Centrality metrics.
+const (
+	InDegree    Metric = "in-degree"
+	OutDegree   Metric = "out-degree"
+	Closeness   Metric = "closeness"
+	Betweenness Metric = "betweenness"
+	PageRank    Metric = "pagerank"
+)
+
+// DefaultDamping is the damping factor used by PageRank when none is provided.
+const DefaultDamping = 0.85
+
+func (m Metric) Validate() error {
+	switch m {
+	case InDegree, OutDegree, Closeness, Betweenness, PageRank:
+		return nil
+	}
+	return Errorf(EINVALID, "unknown metric: %v", m)
+}
+
+// Centrality represents the centrality scores of a person.
+type Centrality struct {
+	Person      *Person `json:"person"`
+	InDegree    int     `json:"inDegree"`
+	OutDegree   int     `json:"outDegree"`
+	Closeness   float64 `json:"closeness"`
+	Betweenness float64 `json:"betweenness"`
+	PageRank    float64 `json:"pagerank"`
+}
+
+// Ranked represents a person alongside their score in a leaderboard.
+type Ranked struct {
+	Person *Person `json:"person"`
+	Score  float64 `json:"score"`
+}
@@ -5,8 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/Lambels/relationer/internal"
 	"github.com/Lambels/relationer/internal/rest"
@@ -136,11 +139,108 @@ func (c *Client) AddFriendship(ctx context.Context, friendship internal.Friendsh
 	return resp.Body.Close()
 }
 
-func (c *Client) GetDepth(ctx context.Context, id1, id2 int64) (int, error) {
+func (c *Client) RemoveFriendship(ctx context.Context, friendship internal.Friendship) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(friendship); err != nil {
+		return internal.WrapError(err, internal.EINTERNAL, "json.Encode")
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodDelete,
+		c.URL+"/friendship",
+		&buf,
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusNoContent {
+		return parseRespErr(resp)
+	}
+	return resp.Body.Close()
+}
+
+func (c *Client) FindPeople(ctx context.Context, where []internal.Property) ([]*internal.Person, error) {
+	query := url.Values{}
+	for _, prop := range where {
+		query.Add("where", prop.String())
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/people?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var people rest.FindPeopleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&people); err != nil {
+		return nil, err
+	}
+
+	return people.People, nil
+}
+
+func (c *Client) SearchPeople(ctx context.Context, query string, limit int) ([]internal.SearchResult, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("limit", fmt.Sprint(limit))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/people/search?"+params.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var results rest.SearchPeopleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	return results.Results, nil
+}
+
+func (c *Client) GetDepth(ctx context.Context, id1, id2 int64, filter internal.EdgeFilter) (int, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodGet,
-		c.URL+"/friendship/depth/"+fmt.Sprint(id1)+"/"+fmt.Sprint(id2),
+		c.URL+"/friendship/depth/"+fmt.Sprint(id1)+"/"+fmt.Sprint(id2)+filterQuery(filter),
 		nil,
 	)
 	if err != nil {
@@ -166,11 +266,11 @@ func (c *Client) GetDepth(ctx context.Context, id1, id2 int64) (int, error) {
 	return depth.Depth, nil
 }
 
-func (c *Client) GetFriendship(ctx context.Context, id int64) (internal.Friendship, error) {
+func (c *Client) GetFriendship(ctx context.Context, id int64, filter internal.EdgeFilter) (internal.Friendship, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodGet,
-		c.URL+"/friendship/"+fmt.Sprint(id),
+		c.URL+"/friendship/"+fmt.Sprint(id)+filterQuery(filter),
 		nil,
 	)
 	if err != nil {
@@ -196,11 +296,11 @@ func (c *Client) GetFriendship(ctx context.Context, id int64) (internal.Friendsh
 	return friendship, nil
 }
 
-func (c *Client) GetAll(ctx context.Context) ([]internal.Friendship, error) {
+func (c *Client) GetAll(ctx context.Context, filter internal.EdgeFilter) ([]internal.Friendship, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodGet,
-		c.URL+"/people/",
+		c.URL+"/people"+filterQuery(filter),
 		nil,
 	)
 	if err != nil {
@@ -226,6 +326,585 @@ func (c *Client) GetAll(ctx context.Context) ([]internal.Friendship, error) {
 	return friendship, nil
 }
 
+func (c *Client) GetPage(ctx context.Context, filter internal.EdgeFilter, page internal.Page) (internal.FriendshipPage, error) {
+	query := url.Values{}
+	query.Set("cursor", fmt.Sprint(page.Cursor))
+	query.Set("limit", fmt.Sprint(page.Limit))
+	setFilter(query, filter)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/people?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return internal.FriendshipPage{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.FriendshipPage{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.FriendshipPage{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var res internal.FriendshipPage
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// StreamAll streams the friendships of everyone as newline delimited json calling fn for
+// each of them in id order, stopping at the first error returned by fn.
+//
+// the whole stream has to be read within the timeout of the http client.
+func (c *Client) StreamAll(ctx context.Context, filter internal.EdgeFilter, fn func(internal.Friendship) error) error {
+	query := url.Values{}
+	query.Set("stream", "true")
+	setFilter(query, filter)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/people?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/x-ndjson")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	dec := json.NewDecoder(resp.Body)
+	for dec.More() {
+		// errors mid stream are sent as a last line holding the error.
+		var line struct {
+			internal.Friendship
+			Error string `json:"error"`
+		}
+		if err := dec.Decode(&line); err != nil {
+			return err
+		}
+		if line.Error != "" {
+			return internal.Errorf(internal.EINTERNAL, "%v", line.Error)
+		}
+
+		if err := fn(line.Friendship); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) Batch(ctx context.Context, ops []internal.BatchOp) (internal.BatchResult, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(ops); err != nil {
+		return internal.BatchResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.URL+"/batch",
+		&buf,
+	)
+	if err != nil {
+		return internal.BatchResult{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.BatchResult{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.BatchResult{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var res internal.BatchResult
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return internal.BatchResult{}, err
+	}
+	return res, nil
+}
+
+func (c *Client) Import(ctx context.Context, imp *internal.Import) (internal.ImportResult, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(imp); err != nil {
+		return internal.ImportResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.URL+"/import?format="+string(internal.ImportJSON),
+		&buf,
+	)
+	if err != nil {
+		return internal.ImportResult{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.ImportResult{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusCreated {
+		return internal.ImportResult{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var res internal.ImportResult
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return internal.ImportResult{}, err
+	}
+	return res, nil
+}
+
+func (c *Client) Export(ctx context.Context, format internal.ExportFormat, filter internal.EdgeFilter, w io.Writer) error {
+	query := url.Values{}
+	query.Set("format", string(format))
+	setFilter(query, filter)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/export?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", format.ContentType())
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func (c *Client) GetReciprocity(ctx context.Context, id1, id2 int64) (internal.Reciprocity, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/friendship/reciprocity/"+fmt.Sprint(id1)+"/"+fmt.Sprint(id2),
+		nil,
+	)
+	if err != nil {
+		return internal.Reciprocity{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.Reciprocity{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.Reciprocity{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var reciprocity internal.Reciprocity
+	if err := json.NewDecoder(resp.Body).Decode(&reciprocity); err != nil {
+		return reciprocity, err
+	}
+
+	return reciprocity, nil
+}
+
+func (c *Client) GetPath(ctx context.Context, id1, id2 int64) ([]*internal.Person, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/friendship/path/"+fmt.Sprint(id1)+"/"+fmt.Sprint(id2),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var path rest.GetPathResponse
+	if err := json.NewDecoder(resp.Body).Decode(&path); err != nil {
+		return nil, err
+	}
+
+	return path.Path, nil
+}
+
+func (c *Client) GetWeightedPath(ctx context.Context, id1, id2 int64) (internal.WeightedPath, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/friendship/distance/"+fmt.Sprint(id1)+"/"+fmt.Sprint(id2),
+		nil,
+	)
+	if err != nil {
+		return internal.WeightedPath{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.WeightedPath{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.WeightedPath{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var path rest.GetWeightedPathResponse
+	if err := json.NewDecoder(resp.Body).Decode(&path); err != nil {
+		return internal.WeightedPath{}, err
+	}
+
+	return internal.WeightedPath{Distance: path.Distance, Path: path.Path}, nil
+}
+
+func (c *Client) GetPaths(ctx context.Context, id1, id2 int64, maxLength, limit int) ([][]*internal.Person, error) {
+	query := url.Values{}
+	query.Set("length", fmt.Sprint(maxLength))
+	query.Set("limit", fmt.Sprint(limit))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/friendship/paths/"+fmt.Sprint(id1)+"/"+fmt.Sprint(id2)+"?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var paths rest.GetPathsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&paths); err != nil {
+		return nil, err
+	}
+
+	return paths.Paths, nil
+}
+
+func (c *Client) GetNetwork(ctx context.Context, id int64, depth int, withEdges bool) (internal.Network, error) {
+	query := url.Values{}
+	query.Set("depth", fmt.Sprint(depth))
+	query.Set("edges", fmt.Sprint(withEdges))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/people/"+fmt.Sprint(id)+"/network?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return internal.Network{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.Network{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.Network{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var network internal.Network
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return network, err
+	}
+
+	return network, nil
+}
+
+func (c *Client) GetMutual(ctx context.Context, id1, id2 int64) ([]*internal.Person, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/friendship/mutual/"+fmt.Sprint(id1)+"/"+fmt.Sprint(id2),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var mutual rest.GetMutualResponse
+	if err := json.NewDecoder(resp.Body).Decode(&mutual); err != nil {
+		return nil, err
+	}
+
+	return mutual.People, nil
+}
+
+func (c *Client) GetRecommendations(ctx context.Context, id int64, limit int, algorithm internal.Algorithm) ([]internal.Recommendation, error) {
+	query := url.Values{}
+	query.Set("limit", fmt.Sprint(limit))
+	query.Set("algorithm", string(algorithm))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/people/"+fmt.Sprint(id)+"/recommendations?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var recommendations rest.GetRecommendationsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&recommendations); err != nil {
+		return nil, err
+	}
+
+	return recommendations.Recommendations, nil
+}
+
+func (c *Client) GetClusters(ctx context.Context, kind internal.ClusterKind) ([]internal.Cluster, error) {
+	query := url.Values{}
+	query.Set("kind", string(kind))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/clusters?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var clusters rest.GetClustersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&clusters); err != nil {
+		return nil, err
+	}
+
+	return clusters.Clusters, nil
+}
+
+func (c *Client) GetStats(ctx context.Context) (internal.Stats, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/stats",
+		nil,
+	)
+	if err != nil {
+		return internal.Stats{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.Stats{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.Stats{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var stats internal.Stats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return internal.Stats{}, err
+	}
+
+	return stats, nil
+}
+
+// Query runs q on the server, the deadline of ctx is sent as the timeout of the query so the
+// server stops searching when the client stops waiting.
+func (c *Client) Query(ctx context.Context, q string) (internal.QueryResult, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(rest.QueryRequest{Query: q}); err != nil {
+		return internal.QueryResult{}, err
+	}
+
+	query := url.Values{}
+	if deadline, ok := ctx.Deadline(); ok {
+		if timeout := time.Until(deadline).Round(time.Millisecond); timeout > 0 {
+			query.Set("timeout", timeout.String())
+		}
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.URL+"/query?"+query.Encode(),
+		&buf,
+	)
+	if err != nil {
+		return internal.QueryResult{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.QueryResult{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.QueryResult{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var res internal.QueryResult
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return internal.QueryResult{}, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetCentrality(ctx context.Context, id int64, damping float64) (internal.Centrality, error) {
+	query := url.Values{}
+	query.Set("damping", fmt.Sprint(damping))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/people/"+fmt.Sprint(id)+"/centrality?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return internal.Centrality{}, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return internal.Centrality{}, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return internal.Centrality{}, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var centrality internal.Centrality
+	if err := json.NewDecoder(resp.Body).Decode(&centrality); err != nil {
+		return centrality, err
+	}
+
+	return centrality, nil
+}
+
+func (c *Client) GetTopCentrality(ctx context.Context, metric internal.Metric, limit int, damping float64) ([]internal.Ranked, error) {
+	query := url.Values{}
+	query.Set("metric", string(metric))
+	query.Set("limit", fmt.Sprint(limit))
+	query.Set("damping", fmt.Sprint(damping))
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/centrality/top?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-type", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+	defer resp.Body.Close()
+
+	var top rest.GetTopCentralityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&top); err != nil {
+		return nil, err
+	}
+
+	return top.People, nil
+}
+
 // parseRespErr parses a json error from the response to a *internal.Error.
 //
 // will close the resp.Body
@@ -247,3 +926,23 @@ func parseRespErr(resp *http.Response) error {
 	}
 	return internal.Errorf(internal.ECodeFromStatusCode(resp.StatusCode), internalErr.Error)
 }
+
+// filterQuery encodes filter as a query string, empty when the filter allows everything.
+func filterQuery(filter internal.EdgeFilter) string {
+	query := url.Values{}
+	setFilter(query, filter)
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
+
+// setFilter adds the types and asOf query params of filter to query.
+func setFilter(query url.Values, filter internal.EdgeFilter) {
+	if len(filter.Types) != 0 {
+		query.Set("types", filter.TypesString())
+	}
+	if !filter.AsOf.IsZero() {
+		query.Set("asOf", filter.AsOf.UTC().Format(time.RFC3339Nano))
+	}
+}
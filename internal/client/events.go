@@ -0,0 +1,87 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// maxEventSize bounds the size of a single line of the event stream.
+const maxEventSize = 1 << 20
+
+// Watch streams the events with routing keys matching the binding keys (every event when none
+// are provided) published once it returns, read as server-sent events from GET /events.
+//
+// the stream isnt bound by the timeout of the http client, the channel is closed once ctx is
+// done or the server ends the stream.
+func (c *Client) Watch(ctx context.Context, keys ...string) (<-chan internal.Event, error) {
+	query := url.Values{}
+	if len(keys) != 0 {
+		query.Set("keys", strings.Join(keys, ","))
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.URL+"/events?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+
+	stream := *c.Client
+	stream.Timeout = 0
+	resp, err := stream.Do(req)
+	if err != nil {
+		return nil, internal.WrapError(err, internal.ECONFLICT, "c.Do")
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, parseRespErr(resp)
+	}
+
+	events := make(chan internal.Event)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 4096), maxEventSize)
+		var key, data string
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, ":") {
+				continue // comments keep the stream alive.
+			}
+			if line != "" {
+				field, value, _ := strings.Cut(line, ":")
+				value = strings.TrimPrefix(value, " ")
+				switch field {
+				case "event":
+					key = value
+				case "data":
+					data += value
+				}
+				continue
+			}
+
+			// blank lines dispatch the event.
+			event, err := internal.DecodeEvent(key, []byte(data))
+			key, data = "", ""
+			if err != nil {
+				continue // skip events unknown to this client.
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
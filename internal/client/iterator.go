@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/service"
+)
+
+// Iterator walks the friendships of everyone in id order, fetching a page at a time.
+//
+//	it := NewIterator(ctx, store, internal.EdgeFilter{}, 100)
+//	for it.Next() {
+//		friendship := it.Friendship()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	ctx    context.Context
+	store  service.GraphStore
+	filter internal.EdgeFilter
+	page   internal.Page
+
+	buf  []internal.Friendship
+	curr internal.Friendship
+	done bool
+	err  error
+}
+
+// NewIterator creates an iterator over store fetching pageSize people at a time.
+func NewIterator(ctx context.Context, store service.GraphStore, filter internal.EdgeFilter, pageSize int) *Iterator {
+	return &Iterator{
+		ctx:    ctx,
+		store:  store,
+		filter: filter,
+		page:   internal.Page{Limit: pageSize},
+	}
+}
+
+// Iterate creates an iterator over the friendships of everyone, fetching pageSize people
+// per request.
+func (c *Client) Iterate(ctx context.Context, filter internal.EdgeFilter, pageSize int) *Iterator {
+	return NewIterator(ctx, c, filter, pageSize)
+}
+
+// Next advances the iterator, reports false when there are no friendships left or an
+// error occurred.
+func (it *Iterator) Next() bool {
+	for len(it.buf) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		res, err := it.store.GetPage(it.ctx, it.filter, it.page)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.buf = res.Friendships
+		it.page.Cursor = res.NextCursor
+		it.done = res.NextCursor == 0
+	}
+
+	it.curr, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Friendship returns the current friendship.
+func (it *Iterator) Friendship() internal.Friendship {
+	return it.curr
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,30 @@
+package internal
+
+// ClusterKind represents the way people are grouped into clusters.
+type ClusterKind string
+
+// Kinds of clusters.
+const (
+	// WeakComponent groups people connected when ignoring friendship direction.
+	WeakComponent ClusterKind = "weak"
+	// StrongComponent groups people who can reach each other following friendship direction.
+	StrongComponent ClusterKind = "strong"
+	// Community groups people detected via label propagation.
+	Community ClusterKind = "community"
+)
+
+func (k ClusterKind) Validate() error {
+	switch k {
+	case WeakComponent, StrongComponent, Community:
+		return nil
+	}
+	return Errorf(EINVALID, "unknown cluster kind: %v", k)
+}
+
+// Cluster represents a group of people, clusters are numbered from the biggest to
+// the smallest.
+type Cluster struct {
+	ID      int     `json:"id"`
+	Size    int     `json:"size"`
+	Members []int64 `json:"members"`
+}
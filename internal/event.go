@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Routing keys of the events published when the graph changes.
+const (
+	PersonCreated     = "person.created"
+	PersonDeleted     = "person.deleted"
+	FriendshipCreated = "friendship.created"
+	FriendshipDeleted = "friendship.deleted"
+)
+
+// Event is a change to the graph, published under Key.
+type Event struct {
+	Key        string      `json:"key"`
+	Time       time.Time   `json:"time"`
+	Person     *Person     `json:"person,omitempty"`     // person.created.
+	Friendship *Friendship `json:"friendship,omitempty"` // friendship.created and friendship.deleted.
+	ID         int64       `json:"id,omitempty"`         // person.deleted.
+}
+
+// Payload returns the body of the event as published to the message broker: the person, the
+// friendship or the id of the person deleted under "id".
+func (e Event) Payload() interface{} {
+	switch e.Key {
+	case PersonCreated:
+		return e.Person
+	case FriendshipCreated, FriendshipDeleted:
+		return e.Friendship
+	}
+	return map[string]int64{"id": e.ID}
+}
+
+// DecodeEvent decodes the payload of an event published under key.
+func DecodeEvent(key string, payload []byte) (Event, error) {
+	event := Event{Key: key}
+
+	var err error
+	switch key {
+	case PersonCreated:
+		err = json.Unmarshal(payload, &event.Person)
+	case FriendshipCreated, FriendshipDeleted:
+		err = json.Unmarshal(payload, &event.Friendship)
+	case PersonDeleted:
+		var body map[string]int64
+		err = json.Unmarshal(payload, &body)
+		event.ID = body["id"]
+	default:
+		return event, Errorf(EINVALID, "unknown routing key: %v", key)
+	}
+	if err != nil {
+		return event, WrapError(err, EINVALID, "json.Unmarshal")
+	}
+	return event, nil
+}
+
+// MatchKey reports whether the routing key matches the binding key pattern like with the
+// topic exchange of the message broker: words are separated by '.', '*' matches a single
+// word and '#' zero or more words.
+func MatchKey(pattern, key string) bool {
+	return matchWords(strings.Split(pattern, "."), strings.Split(key, "."))
+}
+
+func matchWords(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+
+	if pattern[0] == "#" {
+		for i := 0; i <= len(words); i++ {
+			if matchWords(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(words) == 0 || (pattern[0] != "*" && pattern[0] != words[0]) {
+		return false
+	}
+	return matchWords(pattern[1:], words[1:])
+}
+
+// MatchAny reports whether key matches any of the patterns, every key matches when there are
+// none.
+func MatchAny(patterns []string, key string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if MatchKey(pattern, key) {
+			return true
+		}
+	}
+	return false
+}
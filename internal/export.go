@@ -0,0 +1,47 @@
+package internal
+
+// ExportFormat represents the file format the graph is exported to.
+type ExportFormat string
+
+// Export formats.
+const (
+	// GraphML exports the graph as GraphML xml.
+	GraphML ExportFormat = "graphml"
+	// GEXF exports the graph as GEXF xml, used by Gephi.
+	GEXF ExportFormat = "gexf"
+	// DOT exports the graph in the Graphviz language.
+	DOT ExportFormat = "dot"
+	// CSV exports a zip archive holding a nodes.csv and an edges.csv file.
+	CSV ExportFormat = "csv"
+)
+
+func (f ExportFormat) Validate() error {
+	switch f {
+	case GraphML, GEXF, DOT, CSV:
+		return nil
+	}
+	return Errorf(EINVALID, "unknown export format: %v", f)
+}
+
+// ContentType returns the media type of exports in the format.
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case GraphML:
+		return "application/graphml+xml"
+	case GEXF:
+		return "application/gexf+xml"
+	case DOT:
+		return "text/vnd.graphviz"
+	case CSV:
+		return "application/zip"
+	}
+	return "application/octet-stream"
+}
+
+// Extension returns the file extension of exports in the format.
+func (f ExportFormat) Extension() string {
+	if f == CSV {
+		return ".zip"
+	}
+	return "." + string(f)
+}
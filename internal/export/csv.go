@@ -0,0 +1,53 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// writeCSV writes a zip archive holding nodes.csv (id, name, createdAt and a column per
+// property) and edges.csv (source, target, weight, type).
+func writeCSV(w io.Writer, friendships []internal.Friendship) error {
+	archive := zip.NewWriter(w)
+	keys := propertyKeys(friendships)
+
+	nodes, err := archive.Create("nodes.csv")
+	if err != nil {
+		return err
+	}
+	records := [][]string{append([]string{"id", "name", "createdAt"}, keys...)}
+	for _, friendship := range friendships {
+		pers := friendship.P1
+		record := []string{strconv.FormatInt(pers.ID, 10), pers.Name, formatTime(pers.CreatedAt)}
+		for _, key := range keys {
+			record = append(record, pers.Properties[key])
+		}
+		records = append(records, record)
+	}
+	if err := csv.NewWriter(nodes).WriteAll(records); err != nil {
+		return err
+	}
+
+	edgesFile, err := archive.Create("edges.csv")
+	if err != nil {
+		return err
+	}
+	records = [][]string{{"source", "target", "weight", "type"}}
+	for _, e := range edges(friendships) {
+		records = append(records, []string{
+			strconv.FormatInt(e.source, 10),
+			strconv.FormatInt(e.target, 10),
+			strconv.FormatFloat(e.weight, 'g', -1, 64),
+			string(e.kind),
+		})
+	}
+	if err := csv.NewWriter(edgesFile).WriteAll(records); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
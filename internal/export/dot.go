@@ -0,0 +1,33 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+func writeDOT(w io.Writer, friendships []internal.Friendship) error {
+	buf := bufio.NewWriter(w)
+	keys := propertyKeys(friendships)
+
+	fmt.Fprintln(buf, "digraph relationer {")
+	for _, friendship := range friendships {
+		pers := friendship.P1
+		fmt.Fprintf(buf, "  %v [label=%v, createdAt=%v", pers.ID, strconv.Quote(pers.Name), strconv.Quote(formatTime(pers.CreatedAt)))
+		for _, key := range keys {
+			if value, ok := pers.Properties[key]; ok {
+				fmt.Fprintf(buf, ", %v=%v", strconv.Quote(key), strconv.Quote(value))
+			}
+		}
+		fmt.Fprintln(buf, "];")
+	}
+	for _, e := range edges(friendships) {
+		fmt.Fprintf(buf, "  %v -> %v [weight=%v, type=%v];\n", e.source, e.target, e.weight, strconv.Quote(string(e.kind)))
+	}
+	fmt.Fprintln(buf, "}")
+
+	return buf.Flush()
+}
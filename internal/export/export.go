@@ -0,0 +1,69 @@
+// Package export serialises the friendships of the graph into file formats understood by
+// graph tools (Gephi, Graphviz, spreadsheets).
+package export
+
+import (
+	"io"
+	"sort"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// Write encodes the people and friendships in friendships to w in format.
+func Write(w io.Writer, format internal.ExportFormat, friendships []internal.Friendship) error {
+	switch format {
+	case internal.GraphML:
+		return writeGraphML(w, friendships)
+	case internal.GEXF:
+		return writeGEXF(w, friendships)
+	case internal.DOT:
+		return writeDOT(w, friendships)
+	case internal.CSV:
+		return writeCSV(w, friendships)
+	}
+	return format.Validate()
+}
+
+// edge is a single friendship between two people.
+type edge struct {
+	source, target int64
+	weight         float64
+	kind           internal.RelationType
+}
+
+// edges flattens friendships into single edges, keeping their order.
+func edges(friendships []internal.Friendship) []edge {
+	res := make([]edge, 0)
+	for _, friendship := range friendships {
+		for i, with := range friendship.With {
+			res = append(res, edge{
+				source: friendship.P1.ID,
+				target: with,
+				weight: friendship.Weight(i),
+				kind:   friendship.Type(i),
+			})
+		}
+	}
+	return res
+}
+
+// propertyKeys returns the property keys used by any person in ascending order.
+func propertyKeys(friendships []internal.Friendship) []string {
+	seen := make(map[string]bool)
+	keys := make([]string, 0)
+	for _, friendship := range friendships {
+		for key := range friendship.P1.Properties {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,103 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+type gexf struct {
+	XMLName xml.Name  `xml:"gexf"`
+	XMLNS   string    `xml:"xmlns,attr"`
+	Version string    `xml:"version,attr"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfGraph struct {
+	DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode       `xml:"nodes>node"`
+	Edges           []gexfEdge       `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfNode struct {
+	ID        string         `xml:"id,attr"`
+	Label     string         `xml:"label,attr"`
+	AttValues []gexfAttValue `xml:"attvalues>attvalue,omitempty"`
+}
+
+type gexfEdge struct {
+	ID        string         `xml:"id,attr"`
+	Source    string         `xml:"source,attr"`
+	Target    string         `xml:"target,attr"`
+	Weight    float64        `xml:"weight,attr"`
+	AttValues []gexfAttValue `xml:"attvalues>attvalue"`
+}
+
+type gexfAttValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+func writeGEXF(w io.Writer, friendships []internal.Friendship) error {
+	doc := gexf{
+		XMLNS:   "http://gexf.net/1.3",
+		Version: "1.3",
+		Graph: gexfGraph{
+			DefaultEdgeType: "directed",
+			Attributes: []gexfAttributes{
+				{Class: "node", Attributes: []gexfAttribute{{ID: "createdAt", Title: "createdAt", Type: "string"}}},
+				{Class: "edge", Attributes: []gexfAttribute{{ID: "type", Title: "type", Type: "string"}}},
+			},
+		},
+	}
+
+	keys := propertyKeys(friendships)
+	for i, key := range keys {
+		doc.Graph.Attributes[0].Attributes = append(
+			doc.Graph.Attributes[0].Attributes,
+			gexfAttribute{ID: fmt.Sprintf("p%v", i), Title: key, Type: "string"},
+		)
+	}
+
+	for _, friendship := range friendships {
+		pers := friendship.P1
+		node := gexfNode{
+			ID:        strconv.FormatInt(pers.ID, 10),
+			Label:     pers.Name,
+			AttValues: []gexfAttValue{{For: "createdAt", Value: formatTime(pers.CreatedAt)}},
+		}
+		for i, key := range keys {
+			if value, ok := pers.Properties[key]; ok {
+				node.AttValues = append(node.AttValues, gexfAttValue{For: fmt.Sprintf("p%v", i), Value: value})
+			}
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, node)
+	}
+
+	for i, e := range edges(friendships) {
+		doc.Graph.Edges = append(doc.Graph.Edges, gexfEdge{
+			ID:        strconv.Itoa(i),
+			Source:    strconv.FormatInt(e.source, 10),
+			Target:    strconv.FormatInt(e.target, 10),
+			Weight:    e.weight,
+			AttValues: []gexfAttValue{{For: "type", Value: string(e.kind)}},
+		})
+	}
+
+	return writeXML(w, doc)
+}
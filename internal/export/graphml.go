@@ -0,0 +1,113 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func writeGraphML(w io.Writer, friendships []internal.Friendship) error {
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "name", For: "node", Name: "name", Type: "string"},
+			{ID: "createdAt", For: "node", Name: "createdAt", Type: "string"},
+			{ID: "weight", For: "edge", Name: "weight", Type: "double"},
+			{ID: "type", For: "edge", Name: "type", Type: "string"},
+		},
+		Graph: graphMLGraph{ID: "relationer", EdgeDefault: "directed"},
+	}
+
+	// property keys are numbered to avoid clashing with the fixed keys.
+	keys := propertyKeys(friendships)
+	for i, key := range keys {
+		doc.Keys = append(doc.Keys, graphMLKey{ID: fmt.Sprintf("p%v", i), For: "node", Name: key, Type: "string"})
+	}
+
+	for _, friendship := range friendships {
+		pers := friendship.P1
+		node := graphMLNode{
+			ID: strconv.FormatInt(pers.ID, 10),
+			Data: []graphMLData{
+				{Key: "name", Value: pers.Name},
+				{Key: "createdAt", Value: formatTime(pers.CreatedAt)},
+			},
+		}
+		for i, key := range keys {
+			if value, ok := pers.Properties[key]; ok {
+				node.Data = append(node.Data, graphMLData{Key: fmt.Sprintf("p%v", i), Value: value})
+			}
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, node)
+	}
+
+	for i, e := range edges(friendships) {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     fmt.Sprintf("e%v", i),
+			Source: strconv.FormatInt(e.source, 10),
+			Target: strconv.FormatInt(e.target, 10),
+			Data: []graphMLData{
+				{Key: "weight", Value: strconv.FormatFloat(e.weight, 'g', -1, 64)},
+				{Key: "type", Value: string(e.kind)},
+			},
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+// writeXML writes doc as an indented xml document.
+func writeXML(w io.Writer, doc interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -1,9 +1,64 @@
 package internal
 
+// DefaultWeight is the weight of friendships created without one.
+const DefaultWeight float64 = 1
+
 // Friendship represents a friendship from the perspective of P1.
+//
+// when Mutual is set the friendship is symmetric and applies from the perspective of
+// the people in With too.
+//
+// Weights holds the strength of the friendship with each person in With (by index), the
+// higher the weight the closer the people. When empty every friendship has DefaultWeight.
+//
+// Types holds the relationship type with each person in With (by index), a person can
+// appear in With once per type. When empty every friendship has DefaultRelation.
 type Friendship struct {
-	P1   *Person `json:"p1"`
-	With []int64 `json:"with"`
+	P1      *Person        `json:"p1"`
+	With    []int64        `json:"with"`
+	Weights []float64      `json:"weights,omitempty"`
+	Types   []RelationType `json:"types,omitempty"`
+	Mutual  bool           `json:"mutual,omitempty"`
+}
+
+// Weight returns the weight of the friendship with the i-th person in With.
+func (f Friendship) Weight(i int) float64 {
+	if i >= len(f.Weights) {
+		return DefaultWeight
+	}
+	return f.Weights[i]
+}
+
+// Type returns the relationship type with the i-th person in With.
+func (f Friendship) Type(i int) RelationType {
+	if i >= len(f.Types) {
+		return DefaultRelation
+	}
+	return f.Types[i]
+}
+
+// WithDefaults returns a copy of the friendship with the weight and type of each person
+// in With filled in.
+func (f Friendship) WithDefaults() Friendship {
+	weights := make([]float64, len(f.With))
+	types := make([]RelationType, len(f.With))
+	for i := range f.With {
+		weights[i] = f.Weight(i)
+		types[i] = f.Type(i)
+	}
+
+	f.Weights = weights
+	f.Types = types
+	return f
+}
+
+// Reciprocity represents whether a friendship between two people goes both ways.
+type Reciprocity struct {
+	P1           int64 `json:"p1"`
+	P2           int64 `json:"p2"`
+	Forward      bool  `json:"forward"`  // P1 is friends with P2.
+	Backward     bool  `json:"backward"` // P2 is friends with P1.
+	Reciprocated bool  `json:"reciprocated"`
 }
 
 func (f Friendship) Validate() error {
@@ -14,5 +69,23 @@ func (f Friendship) Validate() error {
 	if len(f.With) == 0 {
 		return Errorf(EINVALID, "at least one person is required")
 	}
+
+	if len(f.Weights) != 0 && len(f.Weights) != len(f.With) {
+		return Errorf(EINVALID, "a weight is required for each person")
+	}
+	for _, weight := range f.Weights {
+		if weight <= 0 {
+			return Errorf(EINVALID, "weights must be positive")
+		}
+	}
+
+	if len(f.Types) != 0 && len(f.Types) != len(f.With) {
+		return Errorf(EINVALID, "a relationship type is required for each person")
+	}
+	for _, t := range f.Types {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,213 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/service"
+)
+
+// Batch applies ops in order as a single write to the persistent store, the graph is only
+// updated once the write succeeds so either every operation is applied or none.
+//
+// later operations can reference people created by earlier ones through their ref.
+func (s *GraphStoreService) Batch(ctx context.Context, ops []internal.BatchOp) (internal.BatchResult, error) {
+	if len(ops) == 0 {
+		return internal.BatchResult{}, internal.Errorf(internal.EINVALID, "at least one operation is required")
+	}
+
+	muts, refs, err := s.resolveBatch(ops)
+	if err != nil {
+		return internal.BatchResult{}, err
+	}
+	if err := s.applyBatch(ctx, muts); err != nil {
+		return internal.BatchResult{}, err
+	}
+
+	s.mu.Lock()
+	for _, mut := range muts {
+		if mut.Op == internal.AddFriendshipOp && !s.exists(mut.Link.From.ID, mut.Link.To.ID) {
+			continue // removed meanwhile, ending the friendship with them.
+		}
+		s.apply(mut)
+	}
+	s.mu.Unlock()
+
+	res := internal.BatchResult{
+		Results: make([]internal.BatchOpResult, len(muts)),
+		IDs:     make(map[string]int64, len(refs)),
+	}
+	for i, mut := range muts {
+		res.Results[i] = mut.Result()
+	}
+	for ref, person := range refs {
+		res.IDs[ref] = person.ID
+	}
+	return res, nil
+}
+
+// resolveBatch validates ops and resolves the people they reference, returning the mutations
+// and the people created by ref.
+func (s *GraphStoreService) resolveBatch(ops []internal.BatchOp) ([]internal.Mutation, map[string]*internal.Person, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	muts := make([]internal.Mutation, len(ops))
+	refs := make(map[string]*internal.Person)
+	removed := make(map[*internal.Person]bool)
+	resolve := func(i int, ref internal.PersonRef) (*internal.Person, error) {
+		var person *internal.Person
+		switch {
+		case ref.Ref != "" && ref.ID != 0:
+			return nil, internal.Errorf(internal.EINVALID, "op %v: reference either an id or a ref", i)
+		case ref.Ref != "":
+			if person = refs[ref.Ref]; person == nil {
+				return nil, internal.Errorf(internal.ENOTFOUND, "op %v: unknown ref: %v", i, ref.Ref)
+			}
+		default:
+			if person = s.nodes[ref.ID]; person == nil {
+				return nil, internal.Errorf(internal.ENOTFOUND, "op %v: person %v not found", i, ref.ID)
+			}
+		}
+
+		if removed[person] {
+			return nil, internal.Errorf(internal.ENOTFOUND, "op %v: person %v removed earlier in the batch", i, ref)
+		}
+		return person, nil
+	}
+
+	for i, op := range ops {
+		n := i + 1
+		switch op.Op {
+		case internal.AddPersonOp:
+			if op.Person == nil {
+				return nil, nil, internal.Errorf(internal.EINVALID, "op %v: person is required", n)
+			}
+			if err := op.Person.Validate(); err != nil {
+				return nil, nil, internal.Errorf(internal.ErrorCode(err), "op %v: %v", n, err)
+			}
+			if op.Ref != "" {
+				if _, ok := refs[op.Ref]; ok {
+					return nil, nil, internal.Errorf(internal.EINVALID, "op %v: duplicate ref: %v", n, op.Ref)
+				}
+				refs[op.Ref] = op.Person
+			}
+			muts[i] = internal.Mutation{Op: op.Op, Person: op.Person}
+
+		case internal.AddFriendshipOp, internal.RemoveFriendshipOp:
+			from, err := resolve(n, op.From)
+			if err != nil {
+				return nil, nil, err
+			}
+			to, err := resolve(n, op.To)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			link := internal.Link{From: from, To: to, Weight: op.Weight, Type: op.Type, Mutual: op.Mutual || s.Mutual}
+			if link.Weight == 0 {
+				link.Weight = internal.DefaultWeight
+			}
+			if link.Type == "" {
+				link.Type = internal.DefaultRelation
+			}
+			if err := link.Friendship().Validate(); err != nil {
+				return nil, nil, internal.Errorf(internal.ErrorCode(err), "op %v: %v", n, err)
+			}
+			muts[i] = internal.Mutation{Op: op.Op, Link: link}
+
+		case internal.RemovePersonOp:
+			person, err := resolve(n, op.Target)
+			if err != nil {
+				return nil, nil, err
+			}
+			removed[person] = true
+			muts[i] = internal.Mutation{Op: op.Op, Person: person}
+
+		default:
+			return nil, nil, internal.Errorf(internal.EINVALID, "op %v: unknown operation: %v", n, op.Op)
+		}
+	}
+
+	return muts, refs, nil
+}
+
+// write stores mut, returning it with the time it was stored at when the store records it.
+func (s *GraphStoreService) write(ctx context.Context, mut internal.Mutation) (internal.Mutation, error) {
+	muts := []internal.Mutation{mut}
+	if err := s.applyBatch(ctx, muts); err != nil {
+		return mut, err
+	}
+	return muts[0], nil
+}
+
+// apply applies the stored mut to the graph, recording it in the history at the time it was
+// stored. The caller must hold the lock.
+func (s *GraphStoreService) apply(mut internal.Mutation) {
+	at := mut.At
+	if at.IsZero() {
+		at = s.now() // the store doesnt record when it stored the mutation.
+	}
+
+	switch link := mut.Link; mut.Op {
+	case internal.AddPersonOp:
+		s.addPerson(mut.Person)
+
+	case internal.AddFriendshipOp:
+		s.addFriendship(link.From.ID, link.To.ID, link.Type, link.Weight)
+		s.timeline.openEdge(edgeKey{link.From.ID, link.To.ID, link.Type}, link.Weight, at)
+		if link.Mutual {
+			s.addFriendship(link.To.ID, link.From.ID, link.Type, link.Weight)
+			s.timeline.openEdge(edgeKey{link.To.ID, link.From.ID, link.Type}, link.Weight, at)
+		}
+
+	case internal.RemoveFriendshipOp:
+		s.timeline.closeEdge(edgeKey{link.From.ID, link.To.ID, link.Type}, at)
+		s.removeFriendship(link.From.ID, link.To.ID, link.Type)
+		if link.Mutual {
+			s.timeline.closeEdge(edgeKey{link.To.ID, link.From.ID, link.Type}, at)
+			s.removeFriendship(link.To.ID, link.From.ID, link.Type)
+		}
+
+	case internal.RemovePersonOp:
+		// the history keeps the person and their friendships, ended at.
+		id := mut.Person.ID
+		for friend, rels := range s.inEdges[id] {
+			for kind := range rels {
+				s.timeline.closeEdge(edgeKey{friend, id, kind}, at)
+			}
+		}
+		for friend, rels := range s.edges[id] {
+			for kind := range rels {
+				s.timeline.closeEdge(edgeKey{id, friend, kind}, at)
+			}
+		}
+		s.timeline.closePerson(id, at)
+		s.removePerson(id)
+	}
+}
+
+// applyBatch writes muts to the persistent store, in a single transaction when supported.
+func (s *GraphStoreService) applyBatch(ctx context.Context, muts []internal.Mutation) error {
+	if batch, ok := s.repo.(service.BatchStore); ok {
+		return batch.ApplyBatch(ctx, muts)
+	}
+
+	for _, mut := range muts {
+		var err error
+		switch mut.Op {
+		case internal.AddPersonOp:
+			err = s.repo.AddPerson(ctx, mut.Person)
+		case internal.AddFriendshipOp:
+			err = s.repo.AddFriendship(ctx, mut.Link.Friendship())
+		case internal.RemoveFriendshipOp:
+			err = s.repo.RemoveFriendship(ctx, mut.Link.Friendship())
+		case internal.RemovePersonOp:
+			err = s.repo.RemovePerson(ctx, mut.Person.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/analytics"
+)
+
+// betweennessSamples is the number of sources betweenness is estimated from, it is exact on
+// graphs with fewer people.
+const betweennessSamples = 256
+
+// GetCentrality computes the centrality scores of the person with id over the current
+// snapshot of the graph. Closeness is computed for the person alone, betweenness and
+// PageRank are computed over the whole graph and cached until it changes.
+//
+// returns ENOTFOUND if the person isnt found.
+func (s *GraphStoreService) GetCentrality(ctx context.Context, id int64, damping float64) (internal.Centrality, error) {
+	g, people, version := s.snapshot()
+	pers, ok := people[id]
+	if !ok {
+		return internal.Centrality{}, internal.Errorf(internal.ENOTFOUND, "person not found")
+	}
+
+	in, out := analytics.Degrees(g)
+	betweenness, err := s.betweenness(ctx, g, version)
+	if err != nil {
+		return internal.Centrality{}, err
+	}
+	pageRank, err := s.pageRank(ctx, g, version, damping)
+	if err != nil {
+		return internal.Centrality{}, err
+	}
+
+	return internal.Centrality{
+		Person:      pers,
+		InDegree:    in[id],
+		OutDegree:   out[id],
+		Closeness:   analytics.ClosenessOf(g, id),
+		Betweenness: betweenness[id],
+		PageRank:    pageRank[id],
+	}, nil
+}
+
+// GetTopCentrality ranks the people with the highest metric score over the current snapshot
+// of the graph, returning at most limit people.
+func (s *GraphStoreService) GetTopCentrality(ctx context.Context, metric internal.Metric, limit int, damping float64) ([]internal.Ranked, error) {
+	if err := metric.Validate(); err != nil {
+		return nil, err
+	}
+	if limit < 1 {
+		return nil, internal.Errorf(internal.EINVALID, "limit must be at least 1")
+	}
+
+	g, people, version := s.snapshot()
+	var scores map[int64]float64
+	var err error
+	switch metric {
+	case internal.InDegree, internal.OutDegree:
+		in, out := analytics.Degrees(g)
+		degrees := in
+		if metric == internal.OutDegree {
+			degrees = out
+		}
+
+		scores = make(map[int64]float64, len(g.Nodes))
+		for _, id := range g.Nodes {
+			scores[id] = float64(degrees[id])
+		}
+	case internal.Closeness:
+		scores, err = analytics.Closeness(ctx, g)
+	case internal.Betweenness:
+		scores, err = s.betweenness(ctx, g, version)
+	case internal.PageRank:
+		scores, err = s.pageRank(ctx, g, version, damping)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]internal.Ranked, 0, len(scores))
+	for id, score := range scores {
+		res = append(res, internal.Ranked{Person: people[id], Score: score})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score == res[j].Score {
+			return res[i].Person.ID < res[j].Person.ID
+		}
+		return res[i].Score > res[j].Score
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// snapshot copies the current graph so analytics can run without holding the lock, returning
+// the version of the graph copied.
+func (s *GraphStoreService) snapshot() (*analytics.Graph, map[int64]*internal.Person, uint64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nodes := make([]int64, 0, len(s.nodes))
+	people := make(map[int64]*internal.Person, len(s.nodes))
+	edges := make(map[int64][]int64, len(s.edges))
+	for id, pers := range s.nodes {
+		nodes = append(nodes, id)
+		people[id] = pers
+		edges[id] = s.edges[id].ids()
+	}
+
+	return analytics.NewGraph(nodes, edges), people, s.version
+}
+
+// betweenness returns the betweenness of every person in g, estimated from a sample of
+// sources on large graphs.
+func (s *GraphStoreService) betweenness(ctx context.Context, g *analytics.Graph, version uint64) (map[int64]float64, error) {
+	return s.scores.get(version, "betweenness", func() (map[int64]float64, error) {
+		// sort the people so the same sources are sampled for the same graph.
+		sort.Slice(g.Nodes, func(i, j int) bool { return g.Nodes[i] < g.Nodes[j] })
+		return analytics.SampledBetweenness(ctx, g, betweennessSamples, 1)
+	})
+}
+
+// pageRank returns the PageRank of every person in g with damping.
+func (s *GraphStoreService) pageRank(ctx context.Context, g *analytics.Graph, version uint64, damping float64) (map[int64]float64, error) {
+	return s.scores.get(version, fmt.Sprint("pagerank:", damping), func() (map[int64]float64, error) {
+		return analytics.PageRank(ctx, g, damping)
+	})
+}
+
+// scoreCache caches the scores computed over the whole graph, which dont depend on the
+// person asked about, until the graph changes.
+type scoreCache struct {
+	mu      sync.Mutex
+	version uint64
+	scores  map[string]map[int64]float64
+}
+
+// get returns the scores cached under key for version of the graph, computing them on a
+// miss. Concurrent misses wait for the first one instead of computing the scores again, the
+// scores returned are shared and must not be modified.
+func (c *scoreCache) get(version uint64, key string, compute func() (map[int64]float64, error)) (map[int64]float64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case c.scores == nil || version > c.version:
+		c.version = version
+		c.scores = make(map[string]map[int64]float64)
+	case version < c.version:
+		return compute() // the graph changed since the snapshot, keep the newer scores.
+	}
+
+	if scores, ok := c.scores[key]; ok {
+		return scores, nil
+	}
+	scores, err := compute()
+	if err != nil {
+		return nil, err
+	}
+	c.scores[key] = scores
+	return scores, nil
+}
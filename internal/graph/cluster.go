@@ -0,0 +1,244 @@
+package graph
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// maxPropagationRounds bounds the label propagation rounds in case labels oscillate.
+const maxPropagationRounds = 50
+
+// GetClusters labels every person with the cluster of kind they belong to.
+func (s *GraphStoreService) GetClusters(ctx context.Context, kind internal.ClusterKind) ([]internal.Cluster, error) {
+	if err := kind.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var labels map[int64]int64
+	var err error
+	switch kind {
+	case internal.WeakComponent:
+		labels, err = s.weakComponents(ctx)
+	case internal.StrongComponent:
+		labels, err = s.strongComponents(ctx)
+	case internal.Community:
+		labels, err = s.communities(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return groupLabels(labels), nil
+}
+
+// weakComponents labels people via union-find over the undirected friendships.
+func (s *GraphStoreService) weakComponents(ctx context.Context) (map[int64]int64, error) {
+	parents := make(map[int64]int64, len(s.nodes))
+	for _, pers := range s.nodes {
+		parents[pers.ID] = pers.ID
+	}
+
+	var find func(id int64) int64
+	find = func(id int64) int64 {
+		if parents[id] != id {
+			parents[id] = find(parents[id]) // path compression.
+		}
+		return parents[id]
+	}
+
+	for p1, friends := range s.edges {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		if _, ok := parents[p1]; !ok {
+			continue
+		}
+		for p2 := range friends {
+			if _, ok := parents[p2]; !ok {
+				continue
+			}
+			if r1, r2 := find(p1), find(p2); r1 != r2 {
+				parents[r2] = r1
+			}
+		}
+	}
+
+	labels := make(map[int64]int64, len(parents))
+	for id := range parents {
+		labels[id] = find(id)
+	}
+	return labels, nil
+}
+
+// strongComponents labels people via an iterative tarjan search.
+func (s *GraphStoreService) strongComponents(ctx context.Context) (map[int64]int64, error) {
+	type frame struct {
+		id      int64
+		friends []int64
+		next    int // index of the next friend to visit.
+	}
+
+	var index int
+	indexes := make(map[int64]int, len(s.nodes))
+	lows := make(map[int64]int, len(s.nodes))
+	onStack := make(map[int64]bool)
+	stack := make([]int64, 0)
+	labels := make(map[int64]int64, len(s.nodes))
+
+	for _, root := range s.nodes {
+		if _, ok := indexes[root.ID]; ok {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		indexes[root.ID], lows[root.ID] = index, index
+		index++
+		stack = append(stack, root.ID)
+		onStack[root.ID] = true
+		calls := []frame{{id: root.ID, friends: s.edges[root.ID].ids()}}
+
+		for len(calls) != 0 {
+			top := &calls[len(calls)-1]
+			if top.next < len(top.friends) {
+				j := top.friends[top.next]
+				top.next++
+
+				if _, ok := indexes[j]; !ok {
+					if _, exists := s.nodes[j]; !exists {
+						continue
+					}
+					indexes[j], lows[j] = index, index
+					index++
+					stack = append(stack, j)
+					onStack[j] = true
+					calls = append(calls, frame{id: j, friends: s.edges[j].ids()})
+				} else if onStack[j] && indexes[j] < lows[top.id] {
+					lows[top.id] = indexes[j]
+				}
+				continue
+			}
+
+			// all friends visited, pop the frame.
+			id := top.id
+			calls = calls[:len(calls)-1]
+			if len(calls) != 0 {
+				if parent := calls[len(calls)-1].id; lows[id] < lows[parent] {
+					lows[parent] = lows[id]
+				}
+			}
+
+			if lows[id] == indexes[id] { // id is the root of a component.
+				for {
+					member := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[member] = false
+					labels[member] = id
+					if member == id {
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return labels, nil
+}
+
+// communities labels people via label propagation over the undirected friendships, each
+// person repeatedly adopts the most frequent label among their neighbours (ties keep the
+// current label, else the smallest one wins) until no label changes.
+func (s *GraphStoreService) communities(ctx context.Context) (map[int64]int64, error) {
+	neighbours := s.undirected()
+	ids := make([]int64, 0, len(s.nodes))
+	labels := make(map[int64]int64, len(s.nodes))
+	for _, pers := range s.nodes {
+		ids = append(ids, pers.ID)
+		labels[pers.ID] = pers.ID
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	// visit people in a shuffled order each round so labels dont flood along id order, the
+	// seed is fixed to keep results stable between calls.
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < maxPropagationRounds; round++ {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		rnd.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+		changed := false
+		for _, id := range ids {
+			counts := make(map[int64]int)
+			for j := range neighbours[id] {
+				if label, ok := labels[j]; ok {
+					counts[label]++
+				}
+			}
+			if len(counts) == 0 {
+				continue
+			}
+
+			var best int64
+			var bestCount int
+			for label, count := range counts {
+				if count > bestCount || (count == bestCount && label < best) {
+					best, bestCount = label, count
+				}
+			}
+			if counts[labels[id]] == bestCount { // keep the current label on ties.
+				continue
+			}
+			labels[id] = best
+			changed = true
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	return labels, nil
+}
+
+// groupLabels groups people by label, ordering clusters by size then smallest member.
+func groupLabels(labels map[int64]int64) []internal.Cluster {
+	groups := make(map[int64][]int64)
+	for id, label := range labels {
+		groups[label] = append(groups[label], id)
+	}
+
+	clusters := make([]internal.Cluster, 0, len(groups))
+	for _, members := range groups {
+		sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+		clusters = append(clusters, internal.Cluster{Size: len(members), Members: members})
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Size == clusters[j].Size {
+			return clusters[i].Members[0] < clusters[j].Members[0]
+		}
+		return clusters[i].Size > clusters[j].Size
+	})
+	for i := range clusters {
+		clusters[i].ID = i
+	}
+	return clusters
+}
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/service"
+)
+
+// Import adds the people and friendships of imp with a single write to the persistent store
+// and a single update of the graph.
+//
+// rows failing validation are skipped and reported in the result, the rest of the import
+// is either fully added or not at all.
+func (s *GraphStoreService) Import(ctx context.Context, imp *internal.Import) (internal.ImportResult, error) {
+	if imp == nil {
+		return internal.ImportResult{}, internal.Errorf(internal.EINVALID, "nil pointer")
+	}
+	if s.Mutual {
+		for i := range imp.Edges {
+			imp.Edges[i].Mutual = true
+		}
+	}
+
+	resolved := imp.Resolve()
+	if err := s.addBulk(ctx, resolved.People, resolved.Links); err != nil {
+		return internal.ImportResult{}, err
+	}
+
+	s.mu.Lock()
+	for _, person := range resolved.People {
+		s.addPerson(person)
+	}
+	for _, link := range resolved.Links {
+		if !s.exists(link.From.ID, link.To.ID) {
+			continue // removed meanwhile, ending the friendship with them.
+		}
+		// links are stored alongside the people they link, at the time they were created.
+		s.apply(internal.Mutation{Op: internal.AddFriendshipOp, Link: link, At: link.From.CreatedAt})
+	}
+	s.mu.Unlock()
+
+	return resolved.Result(), nil
+}
+
+// addBulk writes people and links to the persistent store, in a single write when supported.
+func (s *GraphStoreService) addBulk(ctx context.Context, people []*internal.Person, links []internal.Link) error {
+	if bulk, ok := s.repo.(service.BulkStore); ok {
+		return bulk.AddBulk(ctx, people, links)
+	}
+
+	for _, person := range people {
+		if err := s.repo.AddPerson(ctx, person); err != nil {
+			return err
+		}
+	}
+	for _, link := range links {
+		if err := s.repo.AddFriendship(ctx, link.Friendship()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
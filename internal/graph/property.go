@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// propertyIndex indexes people ids by property key then value.
+type propertyIndex map[string]map[string]map[int64]struct{}
+
+func (idx propertyIndex) add(p *internal.Person) {
+	for key, value := range p.Properties {
+		if idx[key] == nil {
+			idx[key] = make(map[string]map[int64]struct{})
+		}
+		if idx[key][value] == nil {
+			idx[key][value] = make(map[int64]struct{})
+		}
+		idx[key][value][p.ID] = struct{}{}
+	}
+}
+
+func (idx propertyIndex) remove(p *internal.Person) {
+	for key, value := range p.Properties {
+		delete(idx[key][value], p.ID)
+		if len(idx[key][value]) == 0 {
+			delete(idx[key], value)
+		}
+		if len(idx[key]) == 0 {
+			delete(idx, key)
+		}
+	}
+}
+
+// FindPeople gets the people matching every property in where, ordered by id. An empty
+// where matches everyone.
+func (s *GraphStoreService) FindPeople(ctx context.Context, where []internal.Property) ([]*internal.Person, error) {
+	return s.findPeople(ctx, where)
+}
+
+func (s *GraphStoreService) findPeople(ctx context.Context, where []internal.Property) ([]*internal.Person, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+	default:
+	}
+
+	var ids []int64
+	if len(where) == 0 {
+		ids = make([]int64, 0, len(s.nodes))
+		for id := range s.nodes {
+			ids = append(ids, id)
+		}
+	} else {
+		// iterate the smallest match and look up the others.
+		sets := make([]map[int64]struct{}, len(where))
+		for i, prop := range where {
+			sets[i] = s.props[prop.Key][prop.Value]
+		}
+		sort.Slice(sets, func(i, j int) bool { return len(sets[i]) < len(sets[j]) })
+
+		ids = make([]int64, 0, len(sets[0]))
+	outer:
+		for id := range sets[0] {
+			for _, set := range sets[1:] {
+				if _, ok := set[id]; !ok {
+					continue outer
+				}
+			}
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	people := make([]*internal.Person, len(ids))
+	for i, id := range ids {
+		people[i] = s.nodes[id]
+	}
+	return people, nil
+}
@@ -0,0 +1,440 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/query"
+)
+
+// Query runs q, written in the query language described in package query, over the graph.
+// The search stops once ctx is done, use a ctx with a short deadline to bound long queries as
+// the read lock is held for the whole search, stalling writers.
+//
+// returns EINVALID if q isnt a valid query.
+func (s *GraphStoreService) Query(ctx context.Context, q string) (internal.QueryResult, error) {
+	parsed, err := query.Parse(q)
+	if err != nil {
+		return internal.QueryResult{}, err
+	}
+	var res internal.QueryResult
+
+	// check cache.
+	if err := s.cache.Get(ctx, "Q"+q, &res); err == nil {
+		return res, nil
+	}
+
+	res, err = s.query(ctx, parsed)
+	if err != nil {
+		return res, err
+	}
+
+	if err := s.cache.Set(ctx, "Q"+q, res, 5*time.Second); err != nil {
+		return res, internal.WrapError(err, internal.EINTERNAL, "cache.Set") // wrap error easy to check for cache error.
+	}
+
+	return res, nil
+}
+
+// errLimit stops the search once the query has all its rows.
+var errLimit = errors.New("limit reached")
+
+func (s *GraphStoreService) query(ctx context.Context, q *query.Query) (internal.QueryResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m := &matcher{
+		ctx:   ctx,
+		s:     s,
+		q:     q,
+		nodes: make(map[string]int64),
+		rels:  make(map[string]internal.Relationship),
+		rows:  make([][]internal.QueryValue, 0),
+	}
+	for _, id := range s.candidates(q.Pattern.Nodes[0]) {
+		if err := m.node(0, id); err != nil {
+			if err == errLimit {
+				break
+			}
+			return internal.QueryResult{}, err
+		}
+	}
+
+	return internal.QueryResult{Columns: q.Columns(), Rows: m.rows}, nil
+}
+
+// candidates returns the ids of the people which could match the first node of a pattern, in
+// ascending order. The property index narrows the people down when the node has properties.
+func (s *GraphStoreService) candidates(node query.NodePattern) []int64 {
+	if lit, ok := node.Props["id"]; ok {
+		if id, ok := lit.Value.(float64); ok && s.nodes[int64(id)] != nil {
+			return []int64{int64(id)}
+		}
+		return nil
+	}
+
+	var smallest map[int64]struct{}
+	found := false
+	for key, lit := range node.Props {
+		if key == "name" {
+			continue
+		}
+		set := s.props[key][text(lit.Value)]
+		if !found || len(set) < len(smallest) {
+			smallest, found = set, true
+		}
+	}
+	if !found {
+		return s.order
+	}
+
+	ids := make([]int64, 0, len(smallest))
+	for id := range smallest {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// step is a relationship followed from a person to the person with id to.
+type step struct {
+	to  int64
+	rel internal.Relationship
+}
+
+// steps returns the relationships from id matching rel, ordered by direction, person then type.
+func (s *GraphStoreService) steps(id int64, rel query.RelPattern) []step {
+	res := make([]step, 0)
+	add := func(adjacent friends, outgoing bool) {
+		for _, with := range adjacent.ids() {
+			if _, ok := s.nodes[with]; !ok {
+				continue
+			}
+
+			types := make([]internal.RelationType, 0, len(adjacent[with]))
+			for t := range adjacent[with] {
+				if allows(rel.Types, t) {
+					types = append(types, t)
+				}
+			}
+			sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+			for _, t := range types {
+				r := internal.Relationship{P1: id, P2: with, Type: t, Weight: adjacent[with][t]}
+				if !outgoing {
+					r.P1, r.P2 = with, id
+				}
+				res = append(res, step{to: with, rel: r})
+			}
+		}
+	}
+
+	if rel.Direction != query.Incoming {
+		add(s.edges[id], true)
+	}
+	if rel.Direction != query.Outgoing {
+		add(s.inEdges[id], false)
+	}
+	return res
+}
+
+func allows(types []string, t internal.RelationType) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, allowed := range types {
+		if internal.RelationType(allowed) == t {
+			return true
+		}
+	}
+	return false
+}
+
+// matcher searches the graph for the paths matching the pattern of a query, depth first.
+type matcher struct {
+	ctx   context.Context
+	s     *GraphStoreService
+	q     *query.Query
+	nodes map[string]int64                 // bound node variables.
+	rels  map[string]internal.Relationship // bound relationship variables.
+	rows  [][]internal.QueryValue
+}
+
+// node matches the person with id against the i-th node of the pattern and continues with
+// the relationship after it.
+func (m *matcher) node(i int, id int64) error {
+	select {
+	case <-m.ctx.Done():
+		return internal.WrapError(m.ctx.Err(), internal.EINVALID, "ctx.Err")
+
+	default:
+	}
+
+	pattern := m.q.Pattern.Nodes[i]
+	person, ok := m.s.nodes[id]
+	if !ok {
+		return nil
+	}
+	for key, lit := range pattern.Props {
+		if c, ok := compare(personField(person, key), lit.Value); !ok || c != 0 {
+			return nil
+		}
+	}
+
+	if pattern.Var != "" {
+		bound, ok := m.nodes[pattern.Var]
+		if ok && bound != id {
+			return nil
+		}
+		if !ok {
+			m.nodes[pattern.Var] = id
+			defer delete(m.nodes, pattern.Var)
+		}
+	}
+
+	if i == len(m.q.Pattern.Rels) {
+		return m.emit()
+	}
+	return m.rel(i, id)
+}
+
+// rel follows the i-th relationship of the pattern from the person with id.
+func (m *matcher) rel(i int, id int64) error {
+	pattern := m.q.Pattern.Rels[i]
+	if pattern.VarLength {
+		reached, err := m.reach(id, pattern)
+		if err != nil {
+			return err
+		}
+		for _, to := range reached {
+			if err := m.node(i+1, to); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if pattern.Var != "" {
+		defer delete(m.rels, pattern.Var)
+	}
+	for _, step := range m.s.steps(id, pattern) {
+		if pattern.Var != "" {
+			m.rels[pattern.Var] = step.rel
+		}
+		if err := m.node(i+1, step.to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reach returns the people whose hop distance from id lies within the bounds of pattern,
+// ordered by distance then id.
+func (m *matcher) reach(id int64, pattern query.RelPattern) ([]int64, error) {
+	res := make([]int64, 0)
+	if pattern.Min == 0 {
+		res = append(res, id)
+	}
+
+	visited := map[int64]bool{id: true}
+	frontier := []int64{id}
+	for depth := 1; depth <= pattern.Max && len(frontier) != 0; depth++ {
+		select {
+		case <-m.ctx.Done():
+			return nil, internal.WrapError(m.ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		next := make([]int64, 0)
+		for _, from := range frontier {
+			for _, step := range m.s.steps(from, pattern) {
+				if !visited[step.to] {
+					visited[step.to] = true
+					next = append(next, step.to)
+				}
+			}
+		}
+		sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+
+		if depth >= pattern.Min {
+			res = append(res, next...)
+		}
+		frontier = next
+	}
+	return res, nil
+}
+
+// emit adds a row for the current bindings when they pass the WHERE clause.
+func (m *matcher) emit() error {
+	if m.q.Where != nil && !m.eval(m.q.Where) {
+		return nil
+	}
+
+	row := make([]internal.QueryValue, len(m.q.Return))
+	for i, item := range m.q.Return {
+		switch id, ok := m.nodes[item.Var]; {
+		case ok && item.Field == "":
+			row[i].Person = m.s.nodes[id]
+		case !ok && item.Field == "":
+			rel := m.rels[item.Var]
+			row[i].Relationship = &rel
+		default:
+			row[i].Value = m.value(query.Operand{Var: item.Var, Field: item.Field})
+		}
+	}
+	m.rows = append(m.rows, row)
+
+	if len(m.rows) == m.q.Limit {
+		return errLimit
+	}
+	return nil
+}
+
+func (m *matcher) eval(expr query.Expr) bool {
+	switch expr := expr.(type) {
+	case *query.Binary:
+		if expr.Op == "AND" {
+			return m.eval(expr.Left) && m.eval(expr.Right)
+		}
+		return m.eval(expr.Left) || m.eval(expr.Right)
+
+	case *query.Not:
+		return !m.eval(expr.Expr)
+
+	case *query.Comparison:
+		l, r := m.value(expr.Left), m.value(expr.Right)
+		if l == nil || r == nil {
+			return false
+		}
+
+		switch expr.Op {
+		case "CONTAINS":
+			return strings.Contains(text(l), text(r))
+		case "STARTS WITH":
+			return strings.HasPrefix(text(l), text(r))
+		case "ENDS WITH":
+			return strings.HasSuffix(text(l), text(r))
+		}
+
+		c, _ := compare(l, r)
+		switch expr.Op {
+		case "=":
+			return c == 0
+		case "<>":
+			return c != 0
+		case "<":
+			return c < 0
+		case "<=":
+			return c <= 0
+		case ">":
+			return c > 0
+		case ">=":
+			return c >= 0
+		}
+	}
+	return false
+}
+
+// value returns the value of operand, nil when the field is missing. A variable without a
+// field evaluates to the id of the person or the relationship itself.
+func (m *matcher) value(operand query.Operand) interface{} {
+	if operand.Literal != nil {
+		return operand.Literal.Value
+	}
+
+	if id, ok := m.nodes[operand.Var]; ok {
+		if operand.Field == "" {
+			return id
+		}
+		return personField(m.s.nodes[id], operand.Field)
+	}
+
+	rel := m.rels[operand.Var]
+	switch operand.Field {
+	case "type":
+		return string(rel.Type)
+	case "weight":
+		return rel.Weight
+	}
+	return rel.String()
+}
+
+// personField returns the id, name or property field of person, nil when missing.
+func personField(person *internal.Person, field string) interface{} {
+	switch field {
+	case "id":
+		return person.ID
+	case "name":
+		return person.Name
+	}
+	if value, ok := person.Properties[field]; ok {
+		return value
+	}
+	return nil
+}
+
+// compare orders l and r, numerically when one is a number and the other a number or numeric
+// string and by their text otherwise. Reports false if either is nil.
+func compare(l, r interface{}) (int, bool) {
+	if l == nil || r == nil {
+		return 0, false
+	}
+
+	if lf, rf, ok := numbers(l, r); ok {
+		switch {
+		case lf < rf:
+			return -1, true
+		case lf > rf:
+			return 1, true
+		}
+		return 0, true
+	}
+	return strings.Compare(text(l), text(r)), true
+}
+
+func numbers(l, r interface{}) (float64, float64, bool) {
+	lf, lok := number(l)
+	rf, rok := number(r)
+	if !lok && !rok {
+		return 0, 0, false
+	}
+
+	var err error
+	if s, ok := l.(string); ok {
+		if lf, err = strconv.ParseFloat(s, 64); err != nil {
+			return 0, 0, false
+		}
+		lok = true
+	}
+	if s, ok := r.(string); ok {
+		if rf, err = strconv.ParseFloat(s, 64); err != nil {
+			return 0, 0, false
+		}
+		rok = true
+	}
+	return lf, rf, lok && rok
+}
+
+func number(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func text(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// GetRecommendations ranks the people the person with id isnt friends with yet by
+// friends-of-friends scoring, returning at most limit recommendations.
+//
+// neighbourhoods are undirected: a person's neighbours are everyone they are friends with
+// and everyone who is friends with them.
+//
+// returns ENOTFOUND if the person isnt found.
+func (s *GraphStoreService) GetRecommendations(ctx context.Context, id int64, limit int, algorithm internal.Algorithm) ([]internal.Recommendation, error) {
+	if err := algorithm.Validate(); err != nil {
+		return nil, err
+	}
+	if limit < 1 {
+		return nil, internal.Errorf(internal.EINVALID, "limit must be at least 1")
+	}
+
+	return s.getRecommendations(ctx, id, limit, algorithm)
+}
+
+func (s *GraphStoreService) getRecommendations(ctx context.Context, id int64, limit int, algorithm internal.Algorithm) ([]internal.Recommendation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.nodes[id]; !ok {
+		return nil, internal.Errorf(internal.ENOTFOUND, "person not found")
+	}
+
+	// only the neighbourhoods of id and its neighbours are needed, built once each.
+	cache := make(map[int64]map[int64]bool)
+	neighbours := func(id int64) map[int64]bool {
+		if _, ok := cache[id]; !ok {
+			cache[id] = s.neighbourhood(id)
+		}
+		return cache[id]
+	}
+
+	// shared holds the common neighbours between id and each candidate.
+	shared := make(map[int64][]int64)
+	for z := range neighbours(id) {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		for candidate := range neighbours(z) {
+			if neighbours(id)[candidate] || candidate == id {
+				continue
+			}
+			shared[candidate] = append(shared[candidate], z)
+		}
+	}
+
+	res := make([]internal.Recommendation, 0, len(shared))
+	for candidate, common := range shared {
+		var score float64
+		switch algorithm {
+		case internal.CommonNeighbours:
+			score = float64(len(common))
+
+		case internal.Jaccard:
+			union := len(neighbours(id)) + len(s.neighbourhood(candidate)) - len(common)
+			score = float64(len(common)) / float64(union)
+
+		case internal.AdamicAdar:
+			for _, z := range common {
+				if degree := len(neighbours(z)); degree > 1 {
+					score += 1 / math.Log(float64(degree))
+				}
+			}
+		}
+
+		pers, ok := s.nodes[candidate]
+		if !ok {
+			continue
+		}
+		res = append(res, internal.Recommendation{Person: pers, Score: score})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score == res[j].Score {
+			return res[i].Person.ID < res[j].Person.ID
+		}
+		return res[i].Score > res[j].Score
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// neighbourhood returns the undirected neighbourhood of the person with id, everyone they are
+// friends with and everyone who is friends with them. The caller must hold the lock.
+func (s *GraphStoreService) neighbourhood(id int64) map[int64]bool {
+	neighbours := make(map[int64]bool, len(s.edges[id])+len(s.inEdges[id]))
+	for with := range s.edges[id] {
+		neighbours[with] = true
+	}
+	for with := range s.inEdges[id] {
+		neighbours[with] = true
+	}
+	delete(neighbours, id)
+	return neighbours
+}
+
+// undirected builds the undirected neighbourhood of every person, the caller must hold the lock.
+func (s *GraphStoreService) undirected() map[int64]map[int64]bool {
+	neighbours := make(map[int64]map[int64]bool, len(s.edges))
+	link := func(p1, p2 int64) {
+		if neighbours[p1] == nil {
+			neighbours[p1] = make(map[int64]bool)
+		}
+		neighbours[p1][p2] = true
+	}
+
+	for p1, friends := range s.edges {
+		for p2 := range friends {
+			if p1 == p2 {
+				continue
+			}
+			link(p1, p2)
+			link(p2, p1)
+		}
+	}
+	return neighbours
+}
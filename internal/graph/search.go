@@ -0,0 +1,232 @@
+package graph
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// nameIndex is a trie over the lowercased words of people names.
+type nameIndex struct {
+	children map[rune]*nameIndex
+	ids      map[int64]struct{} // people with a name word ending here.
+}
+
+func newNameIndex() *nameIndex {
+	return &nameIndex{children: make(map[rune]*nameIndex)}
+}
+
+// words splits a name into its lowercased words.
+func words(name string) []string {
+	return strings.Fields(strings.ToLower(name))
+}
+
+func (n *nameIndex) add(p *internal.Person) {
+	for _, word := range words(p.Name) {
+		curr := n
+		for _, r := range word {
+			next, ok := curr.children[r]
+			if !ok {
+				next = newNameIndex()
+				curr.children[r] = next
+			}
+			curr = next
+		}
+
+		if curr.ids == nil {
+			curr.ids = make(map[int64]struct{})
+		}
+		curr.ids[p.ID] = struct{}{}
+	}
+}
+
+func (n *nameIndex) remove(p *internal.Person) {
+	for _, word := range words(p.Name) {
+		n.removeWord([]rune(word), p.ID)
+	}
+}
+
+// removeWord unlinks id from word, pruning the nodes left empty. Reports whether n is empty.
+func (n *nameIndex) removeWord(word []rune, id int64) bool {
+	if len(word) == 0 {
+		delete(n.ids, id)
+	} else if child, ok := n.children[word[0]]; ok && child.removeWord(word[1:], id) {
+		delete(n.children, word[0])
+	}
+	return len(n.ids) == 0 && len(n.children) == 0
+}
+
+// prefix records in res the length of the shortest word starting with prefix for each person.
+func (n *nameIndex) prefix(prefix string, res map[int64]int) {
+	curr := n
+	for _, r := range prefix {
+		next, ok := curr.children[r]
+		if !ok {
+			return
+		}
+		curr = next
+	}
+	curr.collect(utf8.RuneCountInString(prefix), res)
+}
+
+func (n *nameIndex) collect(length int, res map[int64]int) {
+	for id := range n.ids {
+		if l, ok := res[id]; !ok || length < l {
+			res[id] = length
+		}
+	}
+	for _, child := range n.children {
+		child.collect(length+1, res)
+	}
+}
+
+// fuzzy records in res the smallest edit distance (optimal string alignment, ie: levenshtein
+// counting adjacent transpositions as one edit) between word and the name words of each
+// person, skipping distances over maxDist.
+func (n *nameIndex) fuzzy(word string, maxDist int, res map[int64]int) {
+	target := []rune(word)
+	row := make([]int, len(target)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	for r, child := range n.children {
+		child.fuzzyRow(r, 0, target, row, nil, maxDist, res)
+	}
+}
+
+// fuzzyRow computes the distance row for the node reached by r given the rows of its parent
+// (reached by parentRune) and grandparent, descending only while a distance within maxDist
+// is still reachable.
+func (n *nameIndex) fuzzyRow(r, parentRune rune, target []rune, prev, prevPrev []int, maxDist int, res map[int64]int) {
+	row := make([]int, len(prev))
+	row[0] = prev[0] + 1
+	best := row[0]
+	for i := 1; i < len(row); i++ {
+		cost := 1
+		if target[i-1] == r {
+			cost = 0
+		}
+		row[i] = minInt(row[i-1]+1, prev[i]+1, prev[i-1]+cost)
+		if prevPrev != nil && i > 1 && target[i-1] == parentRune && target[i-2] == r {
+			row[i] = minInt(row[i], prevPrev[i-2]+1) // transposition.
+		}
+		if row[i] < best {
+			best = row[i]
+		}
+	}
+
+	if dist := row[len(row)-1]; dist <= maxDist {
+		for id := range n.ids {
+			if d, ok := res[id]; !ok || dist < d {
+				res[id] = dist
+			}
+		}
+	}
+
+	if best <= maxDist {
+		for next, child := range n.children {
+			child.fuzzyRow(next, r, target, row, prev, maxDist, res)
+		}
+	}
+}
+
+func minInt(first int, rest ...int) int {
+	for _, n := range rest {
+		if n < first {
+			first = n
+		}
+	}
+	return first
+}
+
+// maxTypos returns the edit distance tolerated for a query word of length runes.
+func maxTypos(length int) int {
+	switch {
+	case length < 3:
+		return 0
+	case length <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// SearchPeople searches people by name, every query word has to match a word of the name
+// either as a prefix or with a few typos. Returns at most limit results, best first.
+//
+// exact word matches score 1, prefix matches score higher the more of the word they cover
+// and typo matches score under any prefix match.
+func (s *GraphStoreService) SearchPeople(ctx context.Context, query string, limit int) ([]internal.SearchResult, error) {
+	if len(words(query)) == 0 {
+		return nil, internal.Errorf(internal.EINVALID, "search query is empty")
+	}
+	if limit < 1 {
+		return nil, internal.Errorf(internal.EINVALID, "limit must be at least 1")
+	}
+
+	return s.searchPeople(ctx, query, limit)
+}
+
+func (s *GraphStoreService) searchPeople(ctx context.Context, query string, limit int) ([]internal.SearchResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var scores map[int64]float64
+	for _, word := range words(query) {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		length := utf8.RuneCountInString(word)
+		wordScores := make(map[int64]float64)
+
+		typos := make(map[int64]int)
+		s.names.fuzzy(word, maxTypos(length), typos)
+		for id, dist := range typos {
+			wordScores[id] = 0.5 * (1 - float64(dist)/float64(length+1))
+		}
+
+		prefixes := make(map[int64]int)
+		s.names.prefix(word, prefixes)
+		for id, wordLength := range prefixes {
+			wordScores[id] = 0.5 + 0.5*float64(length)/float64(wordLength)
+		}
+
+		// people have to match every word.
+		if scores == nil {
+			scores = wordScores
+			continue
+		}
+		for id, score := range scores {
+			if wordScore, ok := wordScores[id]; ok {
+				scores[id] = score + wordScore
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	n := float64(len(words(query)))
+	res := make([]internal.SearchResult, 0, len(scores))
+	for id, score := range scores {
+		res = append(res, internal.SearchResult{Person: s.nodes[id], Score: score / n})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score == res[j].Score {
+			return res[i].Person.ID < res[j].Person.ID
+		}
+		return res[i].Score > res[j].Score
+	})
+
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
@@ -0,0 +1,210 @@
+package graph
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// maxEccentricitySources bounds the breadth first searches used to measure the diameter and
+// radius, bigger components are sampled.
+const maxEccentricitySources = 256
+
+// GetStats computes graph-wide statistics over the current graph.
+func (s *GraphStoreService) GetStats(ctx context.Context) (internal.Stats, error) {
+	var res internal.Stats
+
+	// check cache.
+	if err := s.cache.Get(ctx, "S", &res); err == nil {
+		return res, nil
+	}
+
+	stats, err := s.getStats(ctx)
+	if err != nil {
+		return stats, err
+	}
+
+	if err := s.cache.Set(ctx, "S", stats, 5*time.Second); err != nil {
+		return stats, internal.WrapError(err, internal.EINTERNAL, "cache.Set") // wrap error easy to check for cache error.
+	}
+
+	return stats, nil
+}
+
+func (s *GraphStoreService) getStats(ctx context.Context) (internal.Stats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := internal.Stats{
+		People:        len(s.nodes),
+		Relationships: make(map[internal.RelationType]int),
+	}
+
+	in := make(map[int64]int, len(s.nodes))
+	out := make(map[int64]int, len(s.nodes))
+	for p1, friends := range s.edges {
+		if _, ok := s.nodes[p1]; !ok {
+			continue
+		}
+		for p2, rels := range friends {
+			if _, ok := s.nodes[p2]; !ok || len(rels) == 0 {
+				continue
+			}
+			stats.Friendships++
+			out[p1]++
+			in[p2]++
+			for kind := range rels {
+				stats.Relationships[kind]++
+			}
+		}
+	}
+	stats.InDegrees = histogram(in, len(s.nodes))
+	stats.OutDegrees = histogram(out, len(s.nodes))
+	if n := float64(stats.People); n > 0 {
+		stats.MeanDegree = float64(stats.Friendships) / n
+	}
+	if n := float64(stats.People); n > 1 {
+		stats.Density = float64(stats.Friendships) / (n * (n - 1))
+	}
+
+	neighbours := s.undirected()
+	for id := range s.nodes {
+		if len(neighbours[id]) == 0 {
+			stats.Isolated++
+		}
+	}
+
+	weak, err := s.weakComponents(ctx)
+	if err != nil {
+		return internal.Stats{}, err
+	}
+	strong, err := s.strongComponents(ctx)
+	if err != nil {
+		return internal.Stats{}, err
+	}
+	clusters := groupLabels(weak)
+	stats.Components = len(clusters)
+	stats.StrongComponents = len(groupLabels(strong))
+	if len(clusters) != 0 {
+		largest := clusters[0].Members
+		stats.LargestComponent = len(largest)
+		if stats.Diameter, stats.Radius, stats.Estimated, err = eccentricities(ctx, largest, neighbours); err != nil {
+			return internal.Stats{}, err
+		}
+	}
+
+	if stats.Clustering, err = clustering(ctx, s.nodes, neighbours); err != nil {
+		return internal.Stats{}, err
+	}
+	return stats, nil
+}
+
+// histogram counts the people having each degree, people missing from degrees have none.
+func histogram(degrees map[int64]int, people int) []internal.DegreeCount {
+	counts := make(map[int]int)
+	for _, degree := range degrees {
+		counts[degree]++
+	}
+	if zero := people - len(degrees); zero > 0 {
+		counts[0] += zero
+	}
+
+	res := make([]internal.DegreeCount, 0, len(counts))
+	for degree, n := range counts {
+		res = append(res, internal.DegreeCount{Degree: degree, People: n})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Degree < res[j].Degree })
+	return res
+}
+
+// eccentricities returns the diameter and radius of the component made of members (sorted
+// by id), searching from every member or from an evenly spread sample when there are too
+// many in which case the result is an estimate.
+func eccentricities(ctx context.Context, members []int64, neighbours map[int64]map[int64]bool) (int, int, bool, error) {
+	sources := members
+	estimated := len(members) > maxEccentricitySources
+	if estimated {
+		sources = make([]int64, maxEccentricitySources)
+		step := float64(len(members)) / maxEccentricitySources
+		for i := range sources {
+			sources[i] = members[int(float64(i)*step)]
+		}
+	}
+
+	diameter, radius := 0, -1
+	for _, source := range sources {
+		select {
+		case <-ctx.Done():
+			return 0, 0, false, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		// the component is connected, the last level reached is the eccentricity.
+		seen := map[int64]bool{source: true}
+		frontier := []int64{source}
+		ecc := 0
+		for {
+			next := make([]int64, 0)
+			for _, id := range frontier {
+				for j := range neighbours[id] {
+					if !seen[j] {
+						seen[j] = true
+						next = append(next, j)
+					}
+				}
+			}
+			if len(next) == 0 {
+				break
+			}
+			frontier = next
+			ecc++
+		}
+
+		if ecc > diameter {
+			diameter = ecc
+		}
+		if radius == -1 || ecc < radius {
+			radius = ecc
+		}
+	}
+
+	return diameter, radius, estimated, nil
+}
+
+// clustering returns the average local clustering coefficient ignoring friendship direction,
+// people with less than 2 friends have a coefficient of 0.
+func clustering(ctx context.Context, nodes map[int64]*internal.Person, neighbours map[int64]map[int64]bool) (float64, error) {
+	if len(nodes) == 0 {
+		return 0, nil
+	}
+
+	var sum float64
+	for id := range nodes {
+		select {
+		case <-ctx.Done():
+			return 0, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		friends := neighbours[id]
+		k := len(friends)
+		if k < 2 {
+			continue
+		}
+
+		links := 0
+		for a := range friends {
+			for b := range neighbours[a] {
+				if friends[b] {
+					links++ // each link between friends is counted from both ends.
+				}
+			}
+		}
+		sum += float64(links) / float64(k*(k-1))
+	}
+	return sum / float64(len(nodes)), nil
+}
@@ -3,14 +3,94 @@ package graph
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/export"
 	"github.com/Lambels/relationer/internal/service"
 )
 
+const (
+	// MaxPathLength is the largest max length of GetPaths, the number of simple paths grows
+	// exponentially with it.
+	MaxPathLength = 6
+	// MaxPaths is the largest limit of GetPaths.
+	MaxPaths = 1000
+)
+
+// relations maps the relationship types between two people to the weight of each.
+type relations map[internal.RelationType]float64
+
+// allowed reports whether any of the relationships pass filter.
+func (r relations) allowed(filter internal.EdgeFilter) bool {
+	for t := range r {
+		if filter.Allows(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// weight returns the weight of the strongest relationship.
+func (r relations) weight() float64 {
+	var max float64
+	for _, weight := range r {
+		if weight > max {
+			max = weight
+		}
+	}
+	return max
+}
+
+// friends is a set of person ids mapped to the relationships with each.
+type friends map[int64]relations
+
+// ids returns the ids in the set in ascending order.
+func (f friends) ids() []int64 {
+	ids := make([]int64, 0, len(f))
+	for id := range f {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// friendship builds the friendship of p1 out of the relationships passing filter, ordered by
+// id then type. When keep is non nil only the people it keeps are included.
+func (f friends) friendship(p1 *internal.Person, filter internal.EdgeFilter, keep func(int64) bool) internal.Friendship {
+	res := internal.Friendship{
+		P1:      p1,
+		With:    make([]int64, 0),
+		Weights: make([]float64, 0),
+		Types:   make([]internal.RelationType, 0),
+	}
+	for _, id := range f.ids() {
+		if keep != nil && !keep(id) {
+			continue
+		}
+
+		types := make([]internal.RelationType, 0, len(f[id]))
+		for t := range f[id] {
+			if filter.Allows(t) {
+				types = append(types, t)
+			}
+		}
+		sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+		for _, t := range types {
+			res.With = append(res.With, id)
+			res.Weights = append(res.Weights, f[id][t])
+			res.Types = append(res.Types, t)
+		}
+	}
+	return res
+}
+
 // Store is a bi-directional graph ds representing
 // relation-ships between people.
 type GraphStoreService struct {
@@ -18,9 +98,24 @@ type GraphStoreService struct {
 	cache service.Cache
 	db    *sql.DB
 
-	// graph properties.
-	nodes []*internal.Person
-	edges map[int64][]int64
+	// Mutual makes every friendship symmetric, should be set before the store is used.
+	Mutual bool
+
+	// now is the clock used to record the history of the graph.
+	now      func() time.Time
+	timeline *timeline
+
+	// graph properties, indexed by person id.
+	nodes   map[int64]*internal.Person
+	order   []int64 // ids of the nodes in ascending order, used for pagination.
+	edges   map[int64]friends
+	inEdges map[int64]friends // reverse of edges, used to search backwards.
+	props   propertyIndex
+	names   *nameIndex
+	version uint64 // bumped on every change of the graph.
+
+	// scores caches the centrality scores of the whole graph.
+	scores scoreCache
 
 	once sync.Once
 	mu   sync.RWMutex
@@ -29,11 +124,16 @@ type GraphStoreService struct {
 // New initializes a new store.
 func NewGraphStore(db *sql.DB, repo service.Store, cache service.Cache) *GraphStoreService {
 	return &GraphStoreService{
-		repo:  repo,
-		cache: cache,
-		db:    db,
-		nodes: make([]*internal.Person, 0),
-		edges: make(map[int64][]int64),
+		repo:     repo,
+		cache:    cache,
+		db:       db,
+		now:      time.Now,
+		timeline: newTimeline(),
+		nodes:    make(map[int64]*internal.Person),
+		edges:    make(map[int64]friends),
+		inEdges:  make(map[int64]friends),
+		props:    make(propertyIndex),
+		names:    newNameIndex(),
 	}
 }
 
@@ -54,11 +154,12 @@ func (s *GraphStoreService) Load(ctx context.Context) error {
 		s.mu.Lock()
 		defer s.mu.Unlock()
 
-		// load relationships.
+		// load people and relationships, ended ones only make it to the history.
 		rows, err := s.db.QueryContext(ctx, `
-			SELECT people.id, people.name, people.created_at, friendships.person2_id FROM people
-			FULL JOIN friendships ON people.id = friendships.person1_id
-			ORDER BY 1`,
+			SELECT people.id, people.name, people.created_at, people.ended_at, people.properties,
+			friendships.person2_id, friendships.weight, friendships.type, friendships.created_at, friendships.ended_at FROM people
+			LEFT JOIN friendships ON people.id = friendships.person1_id
+			ORDER BY people.id, friendships.created_at`,
 		)
 		if err != nil {
 			doErr = err
@@ -66,35 +167,64 @@ func (s *GraphStoreService) Load(ctx context.Context) error {
 		}
 		defer rows.Close()
 
-		people := make([]*internal.Person, 1) // skip if check on each itteration
-		people[0] = &internal.Person{ID: -1}
-		relations := make(map[int64][]int64, 0)
+		s.nodes = make(map[int64]*internal.Person)
+		s.order = nil
+		s.edges = make(map[int64]friends)
+		s.inEdges = make(map[int64]friends)
+		s.props = make(propertyIndex)
+		s.names = newNameIndex()
+		s.timeline = newTimeline()
+		var last int64
 		for rows.Next() {
 			var person internal.Person
+			var properties []byte
+			var endedAt sql.NullTime
 			var friendID sql.NullInt64
+			var weight sql.NullFloat64
+			var relation sql.NullString
+			var since, until sql.NullTime
 
 			if err := rows.Scan(
 				&person.ID,
 				&person.Name,
 				&person.CreatedAt,
+				&endedAt,
+				&properties,
 				&friendID,
+				&weight,
+				&relation,
+				&since,
+				&until,
 			); err != nil {
 				doErr = err
 				return
 			}
 
-			if people[len(people)-1].ID != person.ID {
-				people = append(people, &person)
+			if person.ID != last {
+				last = person.ID
+				if err := json.Unmarshal(properties, &person.Properties); err != nil {
+					doErr = err
+					return
+				}
+
+				s.timeline.openPerson(&person, person.CreatedAt)
+				if endedAt.Valid {
+					s.timeline.closePerson(person.ID, endedAt.Time)
+				} else {
+					s.addPerson(&person)
+				}
 			}
 
 			if friendID.Valid {
-				relations[person.ID] = append(relations[person.ID], friendID.Int64)
+				key := edgeKey{person.ID, friendID.Int64, internal.RelationType(relation.String)}
+				s.timeline.addVersion(key, weight.Float64, interval{from: since.Time, to: until.Time})
+				if !until.Valid {
+					s.addFriendship(person.ID, friendID.Int64, key.kind, weight.Float64)
+				}
 			}
 		}
 
-		s.nodes = people
-		s.edges = relations
-		doErr = nil
+		doErr = rows.Err()
 	})
 	return doErr
 }
@@ -112,35 +242,112 @@ func (s *GraphStoreService) AddPerson(ctx context.Context, person *internal.Pers
 }
 
 func (s *GraphStoreService) AddFriendship(ctx context.Context, friendship internal.Friendship) error {
+	if err := friendship.Validate(); err != nil {
+		return err
+	}
 	if len(friendship.With) != 1 {
 		return internal.Errorf(internal.ECONFLICT, "provided friendship should only be with one person")
 	}
+	friendship = friendship.WithDefaults()
+	friendship.Mutual = friendship.Mutual || s.Mutual
 
-	if err := s.repo.AddFriendship(ctx, friendship); err != nil {
+	s.mu.RLock()
+	ok := s.exists(friendship.P1.ID, friendship.With[0])
+	from, to := s.nodes[friendship.P1.ID], s.nodes[friendship.With[0]]
+	s.mu.RUnlock()
+	if !ok {
+		return internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	}
+
+	mut, err := s.write(ctx, internal.Mutation{
+		Op: internal.AddFriendshipOp,
+		Link: internal.Link{
+			From:   from,
+			To:     to,
+			Weight: friendship.Weights[0],
+			Type:   friendship.Types[0],
+			Mutual: friendship.Mutual,
+		},
+	})
+	if err != nil {
 		return err
 	}
 
 	s.mu.Lock()
-	s.addFriendship(friendship.P1.ID, friendship.With[0])
+	defer s.mu.Unlock()
+	if !s.exists(friendship.P1.ID, friendship.With[0]) {
+		return nil // removed meanwhile, ending the friendship with them.
+	}
+	s.apply(mut)
+	return nil
+}
+
+// exists reports whether every person with ids is part of the graph, the caller must hold
+// the lock.
+func (s *GraphStoreService) exists(ids ...int64) bool {
+	for _, id := range ids {
+		if _, ok := s.nodes[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveFriendship removes the friendship of the given type from P1 to the person in With.
+//
+// returns ENOTFOUND if the friendship doesent exist.
+func (s *GraphStoreService) RemoveFriendship(ctx context.Context, friendship internal.Friendship) error {
+	if err := friendship.Validate(); err != nil {
+		return err
+	}
+	if len(friendship.With) != 1 {
+		return internal.Errorf(internal.ECONFLICT, "provided friendship should only be with one person")
+	}
+	friendship = friendship.WithDefaults()
+	friendship.Mutual = friendship.Mutual || s.Mutual
+	kind := friendship.Types[0]
+
+	s.mu.RLock()
+	_, ok := s.edges[friendship.P1.ID][friendship.With[0]][kind]
+	_, okReverse := s.edges[friendship.With[0]][friendship.P1.ID][kind]
+	s.mu.RUnlock()
+	if !ok && !(friendship.Mutual && okReverse) {
+		return internal.Errorf(internal.ENOTFOUND, "friendship not found")
+	}
+
+	mut, err := s.write(ctx, internal.Mutation{
+		Op: internal.RemoveFriendshipOp,
+		Link: internal.Link{
+			From:   friendship.P1,
+			To:     &internal.Person{ID: friendship.With[0]},
+			Weight: friendship.Weights[0],
+			Type:   kind,
+			Mutual: friendship.Mutual,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.apply(mut)
 	s.mu.Unlock()
 	return nil
 }
 
 func (s *GraphStoreService) RemovePerson(ctx context.Context, id int64) error {
-	if _, err := s.getPerson(id); err != nil {
+	person, err := s.getPerson(id)
+	if err != nil {
 		return err
 	}
 
-	if err := s.repo.RemovePerson(ctx, id); err != nil {
+	mut, err := s.write(ctx, internal.Mutation{Op: internal.RemovePersonOp, Person: person})
+	if err != nil {
 		return err
 	}
 
 	s.mu.Lock()
-	friends := s.edges[id]
-	for _, id := range friends {
-		s.removeFriendship(id, id) // unlink everyone linked with current person.
-	}
-	s.removePerson(id)
+	s.apply(mut)
 	s.mu.Unlock()
 	return nil
 }
@@ -149,91 +356,272 @@ func (s *GraphStoreService) GetPerson(ctx context.Context, id int64) (*internal.
 	return s.getPerson(id)
 }
 
-// FindDepth uses bfs to find the depth distance between to people, if not related
-// id will be -1.
+// GetDepth uses a bidirectional bfs to find the depth distance between two people, counted
+// as the people on the shortest path (endpoints included). Only friendships passing filter
+// are followed, over the graph as it was at filter.AsOf when set.
 //
-// returns ENOTFOUND if one of the people arent found.
-func (s *GraphStoreService) GetDepth(ctx context.Context, first, second int64) (int, error) {
+// returns ENOTFOUND if one of the people arent found or they arent related.
+func (s *GraphStoreService) GetDepth(ctx context.Context, first, second int64, filter internal.EdgeFilter) (int, error) {
+	if err := filter.Validate(); err != nil {
+		return -1, err
+	}
 	var res int
 
-	// check cache. (1)
-	if err := s.cache.Get(ctx, fmt.Sprintf("D%v%v", first, second), &res); err == nil {
-		return res, nil
-	}
-	// check cache. (2)
-	if err := s.cache.Get(ctx, fmt.Sprintf("D%v%v", second, first), &res); err == nil {
+	// check cache, depth is directional so (second, first) cant be reused.
+	if err := s.cache.Get(ctx, fmt.Sprintf("D%v:%v:%v", first, second, filter), &res); err == nil {
 		return res, nil
 	}
 
 	// fetch depth.
-	depth, err := s.getDepth(ctx, first, second)
+	depth, err := s.view(filter).getDepth(ctx, first, second, filter)
 	if err != nil {
 		return depth, err
 	}
 
-	if err := s.cache.Set(ctx, fmt.Sprintf("D%v%v", first, second), depth, 5*time.Minute); err != nil {
+	if err := s.cache.Set(ctx, fmt.Sprintf("D%v:%v:%v", first, second, filter), depth, 5*time.Minute); err != nil {
 		return depth, internal.WrapError(err, internal.EINTERNAL, "cache.Set") // wrap error easy to check for cache error.
 	}
 
 	return depth, nil
 }
 
-func (s *GraphStoreService) GetFriendship(ctx context.Context, id int64) (internal.Friendship, error) {
+// GetFriendship gets the friendships of the person with id passing filter, as they were at
+// filter.AsOf when set.
+//
+// returns ENOTFOUND if the person isnt found.
+func (s *GraphStoreService) GetFriendship(ctx context.Context, id int64, filter internal.EdgeFilter) (internal.Friendship, error) {
 	var res internal.Friendship
+	if err := filter.Validate(); err != nil {
+		return res, err
+	}
 
 	// search cache.
-	if err := s.cache.Get(ctx, fmt.Sprintf("F%v", id), &res); err == nil {
+	if err := s.cache.Get(ctx, fmt.Sprintf("F%v:%v", id, filter), &res); err == nil {
 		return res, nil
 	}
 
-	pers, err := s.getPerson(id)
+	view := s.view(filter)
+	pers, err := view.getPerson(id)
 	if err != nil {
 		return res, err
 	}
 
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	friends := s.edges[pers.ID]
+	view.mu.RLock()
+	defer view.mu.RUnlock()
 
-	res.P1 = pers
-	res.With = friends
+	res = view.edges[pers.ID].friendship(pers, filter, nil)
 
 	// set cache.
-	if err := s.cache.Set(ctx, fmt.Sprintf("F%v", id), res, 5*time.Second); err != nil {
+	if err := s.cache.Set(ctx, fmt.Sprintf("F%v:%v", id, filter), res, 5*time.Second); err != nil {
 		return res, internal.WrapError(err, internal.EINTERNAL, "cache.Set") // wrap error easy to check for cache error.
 	}
 
 	return res, nil
 }
 
-func (s *GraphStoreService) GetAll(ctx context.Context) ([]internal.Friendship, error) {
-	return s.getAll(ctx)
+// GetPage gets the friendships passing filter of the people in page, ordered by id.
+func (s *GraphStoreService) GetPage(ctx context.Context, filter internal.EdgeFilter, page internal.Page) (internal.FriendshipPage, error) {
+	if err := filter.Validate(); err != nil {
+		return internal.FriendshipPage{}, err
+	}
+	if err := page.Validate(); err != nil {
+		return internal.FriendshipPage{}, err
+	}
+
+	return s.view(filter).getPage(ctx, filter, page)
 }
 
-func (s *GraphStoreService) addPerson(p *internal.Person) {
-	s.nodes = append(s.nodes, p)
+// Export writes the people and their friendships passing filter to w in format.
+func (s *GraphStoreService) Export(ctx context.Context, format internal.ExportFormat, filter internal.EdgeFilter, w io.Writer) error {
+	if err := format.Validate(); err != nil {
+		return err
+	}
+
+	friendships, err := s.GetAll(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	// the snapshot is taken, encode without holding the lock.
+	return internal.WrapErrorNil(export.Write(w, format, friendships), internal.EINTERNAL, "export.Write")
 }
 
-func (s *GraphStoreService) addFriendship(p1, p2 int64) {
-	s.edges[p1] = append(s.edges[p1], p2)
+// GetAll gets the friendships passing filter of every person, ordered by id, as they were at
+// filter.AsOf when set.
+func (s *GraphStoreService) GetAll(ctx context.Context, filter internal.EdgeFilter) ([]internal.Friendship, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.view(filter).getAll(ctx, filter)
 }
 
-func (s *GraphStoreService) getDepth(ctx context.Context, first, target int64) (int, error) {
+// GetReciprocity reports whether the friendship between first and second goes both ways.
+//
+// returns ENOTFOUND if one of the people arent found.
+func (s *GraphStoreService) GetReciprocity(ctx context.Context, first, second int64) (internal.Reciprocity, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	_, ok1 := s.edges[first]
-	_, ok2 := s.edges[target]
-	if ok1 != true || ok2 != true {
-		return -1, internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	_, ok1 := s.nodes[first]
+	_, ok2 := s.nodes[second]
+	if !ok1 || !ok2 {
+		return internal.Reciprocity{}, internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	}
+
+	_, forward := s.edges[first][second]
+	_, backward := s.edges[second][first]
+	return internal.Reciprocity{
+		P1:           first,
+		P2:           second,
+		Forward:      forward,
+		Backward:     backward,
+		Reciprocated: forward && backward,
+	}, nil
+}
+
+// GetPath uses bfs to find the shortest path between two people, the path includes
+// both endpoints. If the people arent related the path will be empty.
+//
+// returns ENOTFOUND if one of the people arent found.
+func (s *GraphStoreService) GetPath(ctx context.Context, first, second int64) ([]*internal.Person, error) {
+	var res []*internal.Person
+
+	// check cache.
+	if err := s.cache.Get(ctx, fmt.Sprintf("P%v:%v", first, second), &res); err == nil {
+		return res, nil
+	}
+
+	// fetch path.
+	path, err := s.getPath(ctx, first, second)
+	if err != nil {
+		return path, err
+	}
+
+	if err := s.cache.Set(ctx, fmt.Sprintf("P%v:%v", first, second), path, 5*time.Second); err != nil {
+		return path, internal.WrapError(err, internal.EINTERNAL, "cache.Set") // wrap error easy to check for cache error.
+	}
+
+	return path, nil
+}
+
+// GetPaths enumerates the simple paths between two people with at most maxLength hops,
+// stopping after limit paths have been found. Each path includes both endpoints.
+//
+// returns EINVALID if maxLength exceeds MaxPathLength or limit exceeds MaxPaths and ENOTFOUND
+// if one of the people arent found.
+func (s *GraphStoreService) GetPaths(ctx context.Context, first, second int64, maxLength, limit int) ([][]*internal.Person, error) {
+	if maxLength < 0 || maxLength > MaxPathLength {
+		return nil, internal.Errorf(internal.EINVALID, "max length must be between 0 and %v", MaxPathLength)
+	}
+	if limit < 1 || limit > MaxPaths {
+		return nil, internal.Errorf(internal.EINVALID, "limit must be between 1 and %v", MaxPaths)
+	}
+
+	return s.getPaths(ctx, first, second, maxLength, limit)
+}
+
+// GetNetwork uses bfs to find everyone within depth hops of the person with id, when
+// withEdges is set the friendships between the people in the network are returned too.
+//
+// returns ENOTFOUND if the person isnt found.
+func (s *GraphStoreService) GetNetwork(ctx context.Context, id int64, depth int, withEdges bool) (internal.Network, error) {
+	if depth < 0 {
+		return internal.Network{}, internal.Errorf(internal.EINVALID, "depth must be positive")
+	}
+
+	return s.getNetwork(ctx, id, depth, withEdges)
+}
+
+// GetMutual returns the people both first and second are friends with.
+//
+// returns ENOTFOUND if one of the people arent found.
+func (s *GraphStoreService) GetMutual(ctx context.Context, first, second int64) ([]*internal.Person, error) {
+	var res []*internal.Person
+
+	// search cache.
+	if err := s.cache.Get(ctx, fmt.Sprintf("M%v:%v", first, second), &res); err == nil {
+		return res, nil
+	}
+
+	mutual, err := s.getMutual(first, second)
+	if err != nil {
+		return mutual, err
+	}
+
+	// set cache.
+	if err := s.cache.Set(ctx, fmt.Sprintf("M%v:%v", first, second), mutual, 5*time.Second); err != nil {
+		return mutual, internal.WrapError(err, internal.EINTERNAL, "cache.Set") // wrap error easy to check for cache error.
+	}
+
+	return mutual, nil
+}
+
+func (s *GraphStoreService) addPerson(p *internal.Person) {
+	s.version++
+	if _, ok := s.nodes[p.ID]; !ok {
+		// ids are mostly increasing, only search when appending would break the order.
+		if n := len(s.order); n == 0 || s.order[n-1] < p.ID {
+			s.order = append(s.order, p.ID)
+		} else {
+			i := sort.Search(n, func(i int) bool { return s.order[i] > p.ID })
+			s.order = append(s.order, 0)
+			copy(s.order[i+1:], s.order[i:])
+			s.order[i] = p.ID
+		}
+	}
+	s.nodes[p.ID] = p
+	s.props.add(p)
+	s.names.add(p)
+
+	at := p.CreatedAt
+	if at.IsZero() {
+		at = s.now()
 	}
+	s.timeline.openPerson(p, at)
+}
 
-	queue := make([]int64, 0)
-	visited := make(map[int64]bool)
-	queue = append(queue, first) // start from first and look for target.
+// addFriendship links p1 to p2 with a relationship of type kind, the history is recorded by
+// the caller.
+func (s *GraphStoreService) addFriendship(p1, p2 int64, kind internal.RelationType, weight float64) {
+	s.version++
+	if s.edges[p1] == nil {
+		s.edges[p1] = make(friends)
+	}
+	if s.inEdges[p2] == nil {
+		s.inEdges[p2] = make(friends)
+	}
+	if s.edges[p1][p2] == nil {
+		// both directions share the relationships, keeping them in sync.
+		rels := make(relations)
+		s.edges[p1][p2] = rels
+		s.inEdges[p2][p1] = rels
+	}
 
-	var count int
-	for {
+	s.edges[p1][p2][kind] = weight
+}
+
+// getDepth only holds the read lock while expanding a level, so long searches dont stall
+// writers, a search running alongside writes sees the graph as it changes.
+func (s *GraphStoreService) getDepth(ctx context.Context, first, target int64, filter internal.EdgeFilter) (int, error) {
+	s.mu.RLock()
+	_, ok1 := s.nodes[first]
+	_, ok2 := s.nodes[target]
+	s.mu.RUnlock()
+	if !ok1 || !ok2 {
+		return -1, internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	}
+	if first == target {
+		return 1, nil
+	}
+
+	// search from both ends, forward from first and backward from target, always expanding
+	// the smaller frontier one full level at a time until the searches meet.
+	forward := map[int64]int{first: 0}
+	backward := map[int64]int{target: 0}
+	forwardFrontier := []int64{first}
+	backwardFrontier := []int64{target}
+	for len(forwardFrontier) != 0 && len(backwardFrontier) != 0 {
 		select {
 		case <-ctx.Done():
 			return -1, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
@@ -241,83 +629,339 @@ func (s *GraphStoreService) getDepth(ctx context.Context, first, target int64) (
 		default:
 		}
 
-		if len(queue) == 0 {
-			return -1, internal.Errorf(internal.ENOTFOUND, "target wasnt found in any relationship connection")
+		var hops int
+		s.mu.RLock()
+		if len(forwardFrontier) <= len(backwardFrontier) {
+			forwardFrontier, hops = expandLevel(forwardFrontier, s.edges, filter, forward, backward)
+		} else {
+			backwardFrontier, hops = expandLevel(backwardFrontier, s.inEdges, filter, backward, forward)
+		}
+		s.mu.RUnlock()
+
+		if hops != -1 {
+			return hops + 1, nil // count nodes, endpoints included.
 		}
+	}
 
-		currID := queue[0]      // seek first item in queue.
-		queue = queue[1:]       // dequeue first item in queue.
-		visited[currID] = true  // mark id as visited.
-		near := s.edges[currID] // get near nodes for enqueueing.
+	return -1, internal.Errorf(internal.ENOTFOUND, "target wasnt found in any relationship connection")
+}
 
-		for i := 0; i < len(near); i++ {
-			j := near[i]
-			if !visited[j] {
-				queue = append(queue, j)
+// expandLevel visits the next level of frontier following the friendships in adj passing
+// filter, recording distances in seen. returns the next frontier and the fewest hops of a
+// path meeting the other search, -1 if the searches didnt meet on this level.
+func expandLevel(frontier []int64, adj map[int64]friends, filter internal.EdgeFilter, seen, other map[int64]int) ([]int64, int) {
+	best := -1
+	next := make([]int64, 0, len(frontier))
+	for _, v := range frontier {
+		for w, rels := range adj[v] {
+			if _, ok := seen[w]; ok || !rels.allowed(filter) {
+				continue
 			}
-		}
+			seen[w] = seen[v] + 1
 
-		count++
-		if currID == target {
-			return count, nil
+			if d, ok := other[w]; ok && (best == -1 || seen[w]+d < best) {
+				best = seen[w] + d
+			}
+			next = append(next, w)
 		}
 	}
+	return next, best
 }
 
-func (s *GraphStoreService) getPerson(id int64) (*internal.Person, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *GraphStoreService) getPath(ctx context.Context, first, target int64) ([]*internal.Person, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	start, ok1 := s.nodes[first]
+	end, ok2 := s.nodes[target]
+	if !ok1 || !ok2 {
+		return nil, internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	}
+	if first == target {
+		return []*internal.Person{start}, nil
+	}
 
-	for _, person := range s.nodes {
-		if person.ID == id {
-			return person, nil
+	queue := []int64{first} // start from first and look for target.
+	parents := map[int64]int64{first: first}
+	for len(queue) != 0 {
+		select {
+		case <-ctx.Done():
+			return nil, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		currID := queue[0] // seek first item in queue.
+		queue = queue[1:]  // dequeue first item in queue.
+
+		for j := range s.edges[currID] {
+			if _, ok := parents[j]; ok {
+				continue
+			}
+			parents[j] = currID
+
+			if j == target {
+				return s.buildPath(parents, first, end), nil
+			}
+			queue = append(queue, j)
 		}
 	}
 
-	return nil, internal.Errorf(internal.ENOTFOUND, "person not found")
+	// not related.
+	return []*internal.Person{}, nil
 }
 
-func (s *GraphStoreService) removeFriendship(p1, p2 int64) {
-	friends := s.edges[p1]
-	for i, friend := range friends {
-		if friend == p2 {
-			friends[i] = friends[len(friends)-1]
-			friends = friends[:len(friends)-1]
+// buildPath walks the parents back from end to first and returns the ordered hops.
+func (s *GraphStoreService) buildPath(parents map[int64]int64, first int64, end *internal.Person) []*internal.Person {
+	path := []*internal.Person{end}
+	for id := parents[end.ID]; ; id = parents[id] {
+		pers := s.nodes[id]
+		path = append(path, pers)
+		if id == first {
 			break
 		}
 	}
 
-	s.edges[p1] = friends
+	// reverse path to go from first to end.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
 }
 
-func (s *GraphStoreService) removePerson(id int64) {
-	for i, pers := range s.nodes {
-		if pers.ID == id {
-			s.nodes[i] = s.nodes[len(s.nodes)-1]
-			s.nodes = s.nodes[:len(s.nodes)-1]
-			break
+func (s *GraphStoreService) getPaths(ctx context.Context, first, target int64, maxLength, limit int) ([][]*internal.Person, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok1 := s.nodes[first]
+	_, ok2 := s.nodes[target]
+	if !ok1 || !ok2 {
+		return nil, internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	}
+
+	paths := make([][]*internal.Person, 0)
+	onPath := map[int64]bool{first: true}
+	current := []int64{first}
+
+	// dfs over the simple paths starting from first, bounded by maxLength hops.
+	var walk func(id int64) error
+	walk = func(id int64) error {
+		select {
+		case <-ctx.Done():
+			return internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		if id == target {
+			path := make([]*internal.Person, len(current))
+			for i, id := range current {
+				path[i] = s.nodes[id]
+			}
+			paths = append(paths, path)
+			return nil
+		}
+		if len(current) > maxLength {
+			return nil
+		}
+
+		for j := range s.edges[id] {
+			if onPath[j] {
+				continue
+			}
+
+			onPath[j] = true
+			current = append(current, j)
+			if err := walk(j); err != nil {
+				return err
+			}
+			current = current[:len(current)-1]
+			onPath[j] = false
+
+			if len(paths) == limit {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	if err := walk(first); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+func (s *GraphStoreService) getNetwork(ctx context.Context, id int64, depth int, withEdges bool) (internal.Network, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	center, ok := s.nodes[id]
+	if !ok {
+		return internal.Network{}, internal.Errorf(internal.ENOTFOUND, "person not found")
+	}
+
+	res := internal.Network{
+		Center: center,
+		People: make([]internal.Neighbour, 0),
+	}
+	distances := map[int64]int{id: 0}
+	level := []int64{id}
+	for dist := 1; dist <= depth && len(level) != 0; dist++ {
+		select {
+		case <-ctx.Done():
+			return internal.Network{}, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		next := make([]int64, 0)
+		for _, currID := range level {
+			for j := range s.edges[currID] {
+				pers, ok := s.nodes[j]
+				if _, seen := distances[j]; seen || !ok {
+					continue
+				}
+				distances[j] = dist
+
+				res.People = append(res.People, internal.Neighbour{Person: pers, Distance: dist})
+				next = append(next, j)
+			}
+		}
+		level = next
+	}
+
+	if withEdges {
+		inNetwork := func(id int64) bool {
+			_, ok := distances[id]
+			return ok
+		}
+
+		res.Edges = make([]internal.Friendship, 0, len(distances))
+		for _, pers := range append([]internal.Neighbour{{Person: center}}, res.People...) {
+			friendship := s.edges[pers.Person.ID].friendship(pers.Person, internal.EdgeFilter{}, inNetwork)
+			res.Edges = append(res.Edges, friendship)
 		}
 	}
+
+	return res, nil
 }
 
-func (s *GraphStoreService) getAll(ctx context.Context) ([]internal.Friendship, error) {
-	friendships := make([]internal.Friendship, 0)
+func (s *GraphStoreService) getMutual(first, second int64) ([]*internal.Person, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok1 := s.nodes[first]
+	_, ok2 := s.nodes[second]
+	if !ok1 || !ok2 {
+		return nil, internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	}
+
+	// iterate the smaller set and look up the bigger one.
+	small, big := s.edges[first], s.edges[second]
+	if len(small) > len(big) {
+		small, big = big, small
+	}
 
+	mutual := make([]*internal.Person, 0)
+	for _, id := range small.ids() {
+		if _, ok := big[id]; !ok {
+			continue
+		}
+		if pers, ok := s.nodes[id]; ok {
+			mutual = append(mutual, pers)
+		}
+	}
+
+	return mutual, nil
+}
+
+func (s *GraphStoreService) getPerson(id int64) (*internal.Person, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	for _, person := range s.nodes {
+
+	if person, ok := s.nodes[id]; ok {
+		return person, nil
+	}
+
+	return nil, internal.Errorf(internal.ENOTFOUND, "person not found")
+}
+
+// removeFriendship removes the relationship of type kind from p1 to p2, unlinking them when
+// no relationships are left.
+func (s *GraphStoreService) removeFriendship(p1, p2 int64, kind internal.RelationType) {
+	s.version++
+	delete(s.edges[p1][p2], kind)
+	if len(s.edges[p1][p2]) != 0 {
+		return
+	}
+
+	delete(s.edges[p1], p2)
+	delete(s.inEdges[p2], p1)
+}
+
+// removePerson removes the person with id alongside all their friendships.
+func (s *GraphStoreService) removePerson(id int64) {
+	s.version++
+	for friend := range s.inEdges[id] {
+		delete(s.edges[friend], id) // unlink everyone linked with current person.
+	}
+	for friend := range s.edges[id] {
+		delete(s.inEdges[friend], id)
+	}
+
+	if p, ok := s.nodes[id]; ok {
+		s.props.remove(p)
+		s.names.remove(p)
+
+		i := sort.Search(len(s.order), func(i int) bool { return s.order[i] >= id })
+		s.order = append(s.order[:i], s.order[i+1:]...)
+	}
+	delete(s.edges, id)
+	delete(s.inEdges, id)
+	delete(s.nodes, id)
+}
+
+func (s *GraphStoreService) getAll(ctx context.Context, filter internal.EdgeFilter) ([]internal.Friendship, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	friendships := make([]internal.Friendship, 0, len(s.order))
+	for _, id := range s.order {
 		select {
 		case <-ctx.Done():
 			return friendships, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
 		default:
 		}
 
-		friends := s.edges[person.ID]
-		friendships = append(friendships, internal.Friendship{
-			P1:   person,
-			With: friends,
-		})
+		friendships = append(friendships, s.edges[id].friendship(s.nodes[id], filter, nil))
 	}
 
 	return friendships, nil
 }
+
+func (s *GraphStoreService) getPage(ctx context.Context, filter internal.EdgeFilter, page internal.Page) (internal.FriendshipPage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	select {
+	case <-ctx.Done():
+		return internal.FriendshipPage{}, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+	default:
+	}
+
+	start := sort.Search(len(s.order), func(i int) bool { return s.order[i] > page.Cursor })
+	end := start + page.Limit
+	if end > len(s.order) {
+		end = len(s.order)
+	}
+
+	res := internal.FriendshipPage{Friendships: make([]internal.Friendship, 0, end-start)}
+	for _, id := range s.order[start:end] {
+		res.Friendships = append(res.Friendships, s.edges[id].friendship(s.nodes[id], filter, nil))
+	}
+	if end < len(s.order) {
+		res.NextCursor = s.order[end-1]
+	}
+	return res, nil
+}
@@ -0,0 +1,1540 @@
+package graph
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/importer"
+	noop "github.com/Lambels/relationer/internal/no-op"
+)
+
+// missCache is a cache which never holds any values.
+type missCache struct{}
+
+func (missCache) Set(context.Context, string, interface{}, time.Duration) error { return nil }
+func (missCache) Delete(context.Context, string) error                          { return nil }
+func (missCache) Get(context.Context, string, interface{}) error {
+	return errors.New("cache miss")
+}
+
+// newRandomGraph creates a graph with n people each with degree random friends.
+func newRandomGraph(n, degree int, seed int64) *GraphStoreService {
+	s := NewGraphStore(nil, nil, missCache{})
+	rnd := rand.New(rand.NewSource(seed))
+
+	for i := 1; i <= n; i++ {
+		s.addPerson(&internal.Person{ID: int64(i), Name: fmt.Sprint(i)})
+	}
+	for i := 1; i <= n; i++ {
+		for j := 0; j < degree; j++ {
+			s.addFriendship(int64(i), rnd.Int63n(int64(n))+1, internal.DefaultRelation, internal.DefaultWeight)
+		}
+	}
+	return s
+}
+
+func TestGetDepth(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	for i := 1; i <= 5; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	s.addFriendship(1, 2, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 3, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(3, 4, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(1, 3, internal.DefaultRelation, internal.DefaultWeight)
+
+	for _, tc := range []struct {
+		first, second int64
+		want          int
+	}{
+		{1, 1, 1},
+		{1, 2, 2},
+		{1, 4, 3},
+		{2, 4, 3},
+	} {
+		depth, err := s.GetDepth(context.Background(), tc.first, tc.second, internal.EdgeFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if depth != tc.want {
+			t.Fatalf("depth(%v, %v) Got: %v Want: %v", tc.first, tc.second, depth, tc.want)
+		}
+	}
+
+	// friendships are directional.
+	if _, err := s.GetDepth(context.Background(), 4, 1, internal.EdgeFilter{}); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", internal.ErrorCode(err), internal.ENOTFOUND)
+	}
+	// 5 isnt related to anyone.
+	if _, err := s.GetDepth(context.Background(), 1, 5, internal.EdgeFilter{}); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", internal.ErrorCode(err), internal.ENOTFOUND)
+	}
+}
+
+func TestGetDepthMatchesPath(t *testing.T) {
+	s := newRandomGraph(2000, 2, 1)
+	rnd := rand.New(rand.NewSource(2))
+
+	for i := 0; i < 200; i++ {
+		first, second := rnd.Int63n(2000)+1, rnd.Int63n(2000)+1
+
+		path, err := s.getPath(context.Background(), first, second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		depth, err := s.getDepth(context.Background(), first, second, internal.EdgeFilter{})
+		if len(path) == 0 {
+			if internal.ErrorCode(err) != internal.ENOTFOUND {
+				t.Fatalf("depth(%v, %v) expected ENOTFOUND got: %v", first, second, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if depth != len(path) {
+			t.Fatalf("depth(%v, %v) Got: %v Want: %v", first, second, depth, len(path))
+		}
+	}
+}
+
+func TestGetDepthConcurrentWrites(t *testing.T) {
+	s := newRandomGraph(2000, 2, 1)
+	s.repo = noop.NewNoopStore()
+	rnd := rand.New(rand.NewSource(2))
+
+	// writers interleave with the levels of the searches.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := int64(1); i <= 200; i++ {
+			s.AddFriendship(context.Background(), internal.Friendship{P1: &internal.Person{ID: i}, With: []int64{i + 1}})
+			s.RemovePerson(context.Background(), 2000-i)
+		}
+	}()
+
+	for i := 0; i < 200; i++ {
+		_, err := s.getDepth(context.Background(), rnd.Int63n(1000)+1, rnd.Int63n(1000)+1, internal.EdgeFilter{})
+		if err != nil && internal.ErrorCode(err) != internal.ENOTFOUND {
+			t.Fatal(err)
+		}
+	}
+	<-done
+}
+
+func TestRemovePersonUnlinks(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	for i := 1; i <= 3; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	s.addFriendship(1, 2, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 3, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(3, 2, internal.DefaultRelation, internal.DefaultWeight)
+
+	if err := s.RemovePerson(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(s.edges[1])+len(s.edges[3]), 0; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := len(s.inEdges[3]), 0; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if _, err := s.GetPerson(context.Background(), 2); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", internal.ErrorCode(err), internal.ENOTFOUND)
+	}
+}
+
+func TestAddFriendshipRemovedPerson(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	ctx := context.Background()
+	for i := 1; i <= 2; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	if err := s.RemovePerson(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// 2 was removed and 99 never existed, from either end of the friendship.
+	for _, ids := range [][2]int64{{1, 2}, {1, 99}, {2, 1}, {99, 1}} {
+		err := s.AddFriendship(ctx, internal.Friendship{P1: &internal.Person{ID: ids[0]}, With: []int64{ids[1]}})
+		if internal.ErrorCode(err) != internal.ENOTFOUND {
+			t.Fatalf("%v: Got: %v Want: %v", ids, err, internal.ENOTFOUND)
+		}
+	}
+
+	friendship, err := s.GetFriendship(ctx, 1, internal.EdgeFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(friendship.With), "[]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+// hookStore runs hook once, while the first batch is being stored.
+type hookStore struct {
+	noop.NoopStore
+	hook func()
+}
+
+func (s *hookStore) ApplyBatch(context.Context, []internal.Mutation) error {
+	if hook := s.hook; hook != nil {
+		s.hook = nil
+		hook()
+	}
+	return nil
+}
+
+func TestBatchRemovedPerson(t *testing.T) {
+	repo := &hookStore{}
+	s := NewGraphStore(nil, repo, missCache{})
+	ctx := context.Background()
+	for i := 1; i <= 2; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+
+	// 2 is removed while the batch linking them is stored.
+	repo.hook = func() {
+		if err := s.RemovePerson(ctx, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ops := []internal.BatchOp{{Op: internal.AddFriendshipOp, From: internal.PersonRef{ID: 1}, To: internal.PersonRef{ID: 2}, Mutual: true}}
+	if _, err := s.Batch(ctx, ops); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.edges[1]) != 0 || len(s.inEdges[1]) != 0 {
+		t.Fatalf("expected no friendships with removed people, got: %v %v", s.edges[1], s.inEdges[1])
+	}
+	if _, ok := s.edges[2]; ok {
+		t.Fatal("expected no friendships of removed people")
+	}
+}
+
+func TestMutualFriendship(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	s.Mutual = true
+	for i := 1; i <= 2; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	ctx := context.Background()
+	friendship := internal.Friendship{P1: &internal.Person{ID: 1}, With: []int64{2}}
+
+	if err := s.AddFriendship(ctx, friendship); err != nil {
+		t.Fatal(err)
+	}
+	reciprocity, err := s.GetReciprocity(ctx, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := reciprocity.Reciprocated, true; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// removing from either side removes both directions.
+	friendship = internal.Friendship{P1: &internal.Person{ID: 2}, With: []int64{1}}
+	if err := s.RemoveFriendship(ctx, friendship); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(s.edges[1])+len(s.edges[2]), 0; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetWeightedPath(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	for i := 1; i <= 4; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	// 1 -> 4 directly is a weak friendship, going through 2 and 3 is closer.
+	s.addFriendship(1, 4, internal.DefaultRelation, 1)
+	s.addFriendship(1, 2, internal.DefaultRelation, 10)
+	s.addFriendship(2, 3, internal.DefaultRelation, 10)
+	s.addFriendship(3, 4, internal.DefaultRelation, 10)
+
+	path, err := s.GetWeightedPath(context.Background(), 1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(path.Path), 4; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := path.Distance, 0.3; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// friendships are directional.
+	path, err = s.GetWeightedPath(context.Background(), 4, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(path.Path), 0; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetPaths(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	for i := 1; i <= 5; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	s.addFriendship(1, 4, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(1, 2, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(1, 3, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 4, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(3, 4, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 3, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(4, 1, internal.DefaultRelation, internal.DefaultWeight) // cycles arent followed.
+
+	// ids returns the paths as sorted lists of ids, paths arent found in any order.
+	ids := func(paths [][]*internal.Person) []string {
+		res := make([]string, 0, len(paths))
+		for _, path := range paths {
+			hops := make([]int64, len(path))
+			for i, p := range path {
+				hops[i] = p.ID
+			}
+			res = append(res, fmt.Sprint(hops))
+		}
+		sort.Strings(res)
+		return res
+	}
+
+	for _, test := range []struct {
+		maxLength, limit int
+		want             string
+	}{
+		{3, 10, "[[1 2 3 4] [1 2 4] [1 3 4] [1 4]]"},
+		{2, 10, "[[1 2 4] [1 3 4] [1 4]]"},
+		{1, 10, "[[1 4]]"},
+		{0, 10, "[]"},
+	} {
+		paths, err := s.GetPaths(context.Background(), 1, 4, test.maxLength, test.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprint(ids(paths)); got != test.want {
+			t.Fatalf("length %v: Got: %v Want: %v", test.maxLength, got, test.want)
+		}
+	}
+
+	paths, err := s.GetPaths(context.Background(), 1, 4, 3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(paths), 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// 5 isnt related to anyone.
+	paths, err = s.GetPaths(context.Background(), 1, 5, 3, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(paths), 0; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	if _, err := s.GetPaths(context.Background(), 1, 6, 3, 10); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", err, internal.ENOTFOUND)
+	}
+	for _, bounds := range [][2]int{{MaxPathLength + 1, 10}, {3, MaxPaths + 1}, {-1, 10}, {3, 0}} {
+		if _, err := s.GetPaths(context.Background(), 1, 4, bounds[0], bounds[1]); internal.ErrorCode(err) != internal.EINVALID {
+			t.Fatalf("%v: Got: %v Want: %v", bounds, err, internal.EINVALID)
+		}
+	}
+}
+
+func TestGetNetwork(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	for i := 1; i <= 4; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	s.addFriendship(1, 2, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 3, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(3, 4, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 99, internal.DefaultRelation, internal.DefaultWeight) // dangling, 99 isnt a person.
+
+	network, err := s.GetNetwork(context.Background(), 1, 2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var people []string
+	for _, n := range network.People {
+		people = append(people, fmt.Sprintf("%v:%v", n.Person.ID, n.Distance))
+	}
+	if got, want := fmt.Sprint(people), "[2:1 3:2]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// edges only link people in the network.
+	var edges []string
+	for _, f := range network.Edges {
+		edges = append(edges, fmt.Sprint(f.P1.ID, f.With))
+	}
+	if got, want := fmt.Sprint(edges), "[1 [2] 2 [3] 3 []]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	if _, err := s.GetNetwork(context.Background(), 5, 2, false); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", err, internal.ENOTFOUND)
+	}
+}
+
+func TestGetMutual(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	for i := 1; i <= 6; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	for _, with := range []int64{3, 4, 5} {
+		s.addFriendship(1, with, internal.DefaultRelation, internal.DefaultWeight)
+	}
+	for _, with := range []int64{5, 6, 3} {
+		s.addFriendship(2, with, internal.Colleague, internal.DefaultWeight)
+	}
+	s.addFriendship(4, 2, internal.DefaultRelation, internal.DefaultWeight) // only 4 is friends with 2.
+
+	mutual, err := s.GetMutual(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, p := range mutual {
+		ids = append(ids, p.ID)
+	}
+	if got, want := fmt.Sprint(ids), "[3 5]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	mutual, err = s.GetMutual(context.Background(), 1, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(mutual), 0; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	if _, err := s.GetMutual(context.Background(), 1, 7); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", err, internal.ENOTFOUND)
+	}
+}
+
+func TestGetRecommendations(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	for i := 1; i <= 6; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	s.addFriendship(1, 2, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(3, 1, internal.DefaultRelation, internal.DefaultWeight) // 3 already befriended 1.
+	s.addFriendship(2, 3, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 4, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(3, 4, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 5, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(6, 5, internal.DefaultRelation, internal.DefaultWeight)
+
+	// 4 shares 2 and 3 with 1, 5 only shares 2.
+	for _, test := range []struct {
+		algorithm internal.Algorithm
+		want      []float64
+	}{
+		{internal.CommonNeighbours, []float64{2, 1}},
+		{internal.Jaccard, []float64{1, 1.0 / 3}},
+		{internal.AdamicAdar, []float64{1/math.Log(4) + 1/math.Log(3), 1 / math.Log(4)}},
+	} {
+		recs, err := s.GetRecommendations(context.Background(), 1, 10, test.algorithm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(recs), 2; got != want {
+			t.Fatalf("%v: Got: %v Want: %v", test.algorithm, got, want)
+		}
+		for i, id := range []int64{4, 5} {
+			if got, want := recs[i].Person.ID, id; got != want {
+				t.Fatalf("%v: Got: %v Want: %v", test.algorithm, got, want)
+			}
+			if got, want := recs[i].Score, test.want[i]; math.Abs(got-want) > 1e-9 {
+				t.Fatalf("%v: Got: %v Want: %v", test.algorithm, got, want)
+			}
+		}
+	}
+
+	recs, err := s.GetRecommendations(context.Background(), 1, 1, internal.CommonNeighbours)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(recs), 1; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	if _, err := s.GetRecommendations(context.Background(), 7, 10, internal.Jaccard); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", err, internal.ENOTFOUND)
+	}
+}
+
+func TestGetClusters(t *testing.T) {
+	// graph builds a store of n people linked by edges.
+	graph := func(n int, edges [][2]int64) *GraphStoreService {
+		s := NewGraphStore(nil, nil, missCache{})
+		for i := 1; i <= n; i++ {
+			s.addPerson(&internal.Person{ID: int64(i)})
+		}
+		for _, e := range edges {
+			s.addFriendship(e[0], e[1], internal.DefaultRelation, internal.DefaultWeight)
+		}
+		return s
+	}
+	members := func(clusters []internal.Cluster) string {
+		res := make([][]int64, len(clusters))
+		for i, c := range clusters {
+			res[i] = c.Members
+		}
+		return fmt.Sprint(res)
+	}
+
+	// two components ignoring direction, 6 on its own.
+	weak := graph(6, [][2]int64{{1, 2}, {3, 2}, {4, 5}})
+	// a directed cycle with a tail leading out of it.
+	strong := graph(6, [][2]int64{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}})
+	// two cliques joined by a single bridge.
+	var cliques [][2]int64
+	for _, clique := range [][]int64{{1, 2, 3, 4}, {5, 6, 7, 8}} {
+		for _, p1 := range clique {
+			for _, p2 := range clique {
+				if p1 != p2 {
+					cliques = append(cliques, [2]int64{p1, p2})
+				}
+			}
+		}
+	}
+	community := graph(8, append(cliques, [2]int64{4, 5}))
+
+	for _, test := range []struct {
+		s    *GraphStoreService
+		kind internal.ClusterKind
+		want string
+	}{
+		{weak, internal.WeakComponent, "[[1 2 3] [4 5] [6]]"},
+		{strong, internal.StrongComponent, "[[1 2 3] [4] [5] [6]]"},
+		{strong, internal.WeakComponent, "[[1 2 3 4 5] [6]]"},
+		{community, internal.Community, "[[1 2 3 4] [5 6 7 8]]"},
+	} {
+		clusters, err := test.s.GetClusters(context.Background(), test.kind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := members(clusters); got != test.want {
+			t.Fatalf("%v: Got: %v Want: %v", test.kind, got, test.want)
+		}
+		for i, c := range clusters {
+			if c.ID != i || c.Size != len(c.Members) {
+				t.Fatalf("%v: invalid cluster: %+v", test.kind, c)
+			}
+		}
+	}
+
+	if _, err := weak.GetClusters(context.Background(), "louvain"); internal.ErrorCode(err) != internal.EINVALID {
+		t.Fatalf("Got: %v Want: %v", err, internal.EINVALID)
+	}
+}
+
+func TestGetCentrality(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	for i := 1; i <= 4; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	s.addFriendship(1, 2, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(2, 3, internal.DefaultRelation, internal.DefaultWeight)
+
+	centrality, err := s.GetCentrality(context.Background(), 2, 0.85)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2 lies on the only path between 1 and 3, out of the 6 ordered pairs of 4 people.
+	if got, want := centrality.Betweenness, 1.0/6; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := centrality.Closeness, 1.0/3; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// the cached scores are dropped once the graph changes.
+	s.addFriendship(3, 4, internal.DefaultRelation, internal.DefaultWeight)
+	centrality, err = s.GetCentrality(context.Background(), 2, 0.85)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := centrality.Betweenness, 2.0/6; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	if _, err := s.GetCentrality(context.Background(), 5, 0.85); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", err, internal.ENOTFOUND)
+	}
+}
+
+func TestTypedFriendships(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	for i := 1; i <= 3; i++ {
+		s.addPerson(&internal.Person{ID: int64(i)})
+	}
+	ctx := context.Background()
+	s.addFriendship(1, 2, internal.Friend, internal.DefaultWeight)
+	s.addFriendship(1, 2, internal.Colleague, internal.DefaultWeight)
+	s.addFriendship(2, 3, internal.Colleague, internal.DefaultWeight)
+
+	friends := internal.EdgeFilter{Types: []internal.RelationType{internal.Friend}}
+	if _, err := s.GetDepth(ctx, 1, 3, friends); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", internal.ErrorCode(err), internal.ENOTFOUND)
+	}
+	colleagues := internal.EdgeFilter{Types: []internal.RelationType{internal.Colleague}}
+	depth, err := s.GetDepth(ctx, 1, 3, colleagues)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := depth, 3; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// each relationship type is listed on its own.
+	friendship, err := s.GetFriendship(ctx, 1, internal.EdgeFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(friendship.With), 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// removing one layer keeps the others.
+	removed := internal.Friendship{
+		P1:    &internal.Person{ID: 1},
+		With:  []int64{2},
+		Types: []internal.RelationType{internal.Colleague},
+	}
+	if err := s.RemoveFriendship(ctx, removed); err != nil {
+		t.Fatal(err)
+	}
+	all, err := s.GetAll(ctx, internal.EdgeFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := all[0].Types, []internal.RelationType{internal.Friend}; len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestFindPeople(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	s.addPerson(&internal.Person{ID: 1, Properties: map[string]string{"team": "infra", "city": "berlin"}})
+	s.addPerson(&internal.Person{ID: 2, Properties: map[string]string{"team": "infra", "city": "paris"}})
+	s.addPerson(&internal.Person{ID: 3, Properties: map[string]string{"team": "web", "city": "berlin"}})
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		where []internal.Property
+		want  []int64
+	}{
+		{nil, []int64{1, 2, 3}},
+		{[]internal.Property{{Key: "team", Value: "infra"}}, []int64{1, 2}},
+		{[]internal.Property{{Key: "team", Value: "infra"}, {Key: "city", Value: "berlin"}}, []int64{1}},
+		{[]internal.Property{{Key: "team", Value: "sales"}}, []int64{}},
+	} {
+		people, err := s.FindPeople(ctx, tc.where)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(people), len(tc.want); got != want {
+			t.Fatalf("where %v Got: %v Want: %v", tc.where, got, want)
+		}
+		for i, person := range people {
+			if got, want := person.ID, tc.want[i]; got != want {
+				t.Fatalf("where %v Got: %v Want: %v", tc.where, got, want)
+			}
+		}
+	}
+
+	// removed people leave the index.
+	if err := s.RemovePerson(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(s.props["team"]["infra"]), 1; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestSearchPeople(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	for i, name := range []string{"Alice Smith", "Alicia Keys", "Bob Smithson", "Alan Turing"} {
+		s.addPerson(&internal.Person{ID: int64(i + 1), Name: name})
+	}
+
+	for _, tc := range []struct {
+		query string
+		want  []int64
+	}{
+		{"alice", []int64{1}},       // exact match ranks first.
+		{"ali", []int64{1, 2}},      // prefix, shorter completions first.
+		{"smith", []int64{1, 3}},    // any word of the name.
+		{"alcie", []int64{1}},       // typo.
+		{"ALAN", []int64{4}},        // case insensitive.
+		{"alice smith", []int64{1}}, // every word has to match.
+		{"bo smithsn", []int64{3}},  // prefix and typo.
+		{"bob alice", []int64{}},    // words from different people.
+		{"smithsonian", []int64{}},  // too many typos.
+	} {
+		results, err := s.SearchPeople(context.Background(), tc.query, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]int64, len(results))
+		for i, result := range results {
+			got[i] = result.Person.ID
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Fatalf("search(%q) Got: %v Want: %v", tc.query, got, tc.want)
+		}
+	}
+
+	// removed people leave the index.
+	if err := s.RemovePerson(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	results, err := s.SearchPeople(context.Background(), "alice", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(results), 0; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	for _, id := range []int64{5, 1, 3, 2, 4} {
+		s.addPerson(&internal.Person{ID: id})
+	}
+	ctx := context.Background()
+	if err := s.RemovePerson(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int64
+	page := internal.Page{Limit: 2}
+	for {
+		res, err := s.GetPage(ctx, internal.EdgeFilter{}, page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, friendship := range res.Friendships {
+			got = append(got, friendship.P1.ID)
+		}
+
+		if res.NextCursor == 0 {
+			break
+		}
+		page.Cursor = res.NextCursor
+	}
+
+	if want := []int64{1, 2, 4, 5}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestExport(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	s.addPerson(&internal.Person{ID: 1, Name: "Alice", Properties: map[string]string{"city": "Paris"}})
+	s.addPerson(&internal.Person{ID: 2, Name: "Bob & co"})
+	s.addFriendship(1, 2, internal.Colleague, 2)
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	if err := s.Export(ctx, internal.GraphML, internal.EdgeFilter{}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var graphml struct {
+		Nodes []struct {
+			ID string `xml:"id,attr"`
+		} `xml:"graph>node"`
+		Edges []struct {
+			Source string `xml:"source,attr"`
+			Target string `xml:"target,attr"`
+		} `xml:"graph>edge"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &graphml); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(graphml.Nodes), 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := len(graphml.Edges), 1; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	buf.Reset()
+	if err := s.Export(ctx, internal.CSV, internal.EdgeFilter{}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	if want := []string{"nodes.csv", "edges.csv"}; fmt.Sprint(names) != fmt.Sprint(want) {
+		t.Fatalf("Got: %v Want: %v", names, want)
+	}
+
+	// friends only, the colleague edge is filtered out.
+	buf.Reset()
+	filter := internal.EdgeFilter{Types: []internal.RelationType{internal.Friend}}
+	if err := s.Export(ctx, internal.DOT, filter, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "->") {
+		t.Fatalf("Got: %v Want: no edges", buf.String())
+	}
+
+	if err := s.Export(ctx, "pdf", internal.EdgeFilter{}, &buf); internal.ErrorCode(err) != internal.EINVALID {
+		t.Fatalf("Got: %v Want: %v", err, internal.EINVALID)
+	}
+}
+
+// seqStore is a store which generates sequential ids.
+type seqStore struct {
+	noop.NoopStore
+	last int64
+}
+
+func (s *seqStore) AddPerson(_ context.Context, p *internal.Person) error {
+	s.last++
+	p.ID = s.last
+	return nil
+}
+
+func (s *seqStore) AddBulk(ctx context.Context, people []*internal.Person, _ []internal.Link) error {
+	for _, p := range people {
+		s.AddPerson(ctx, p)
+	}
+	return nil
+}
+
+func (s *seqStore) ApplyBatch(ctx context.Context, muts []internal.Mutation) error {
+	for _, mut := range muts {
+		if mut.Op == internal.AddPersonOp {
+			s.AddPerson(ctx, mut.Person)
+		}
+	}
+	return nil
+}
+
+func TestImport(t *testing.T) {
+	s := NewGraphStore(nil, &seqStore{}, missCache{})
+	imp := &internal.Import{
+		Nodes: []internal.ImportNode{
+			{ID: "a", Name: "Alice", Properties: map[string]string{"team": "infra"}},
+			{ID: "b", Name: "Bob"},
+			{ID: "c"},              // missing name.
+			{ID: "a", Name: "Ann"}, // duplicate id.
+		},
+		Edges: []internal.ImportEdge{
+			{Source: "a", Target: "b", Mutual: true},
+			{Source: "b", Target: "a"}, // covered by the mutual friendship.
+			{Source: "a", Target: "c"},
+			{Source: "b", Target: "a", Type: internal.Colleague, Weight: 3},
+		},
+	}
+
+	res, err := s.Import(context.Background(), imp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(res.IDs), "map[a:1 b:2]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	var rows []int
+	for _, rowErr := range res.Errors {
+		rows = append(rows, rowErr.Row)
+	}
+	if got, want := fmt.Sprint(rows), "[3 4 2 3]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := len(res.Friendships), 2; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	friendship, err := s.GetFriendship(context.Background(), 2, internal.EdgeFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(friendship.With, friendship.Types), "[1 1] [colleague friend]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if people, _ := s.FindPeople(context.Background(), []internal.Property{{Key: "team", Value: "infra"}}); len(people) != 1 {
+		t.Fatalf("Got: %v Want: %v", len(people), 1)
+	}
+}
+
+func TestImportExported(t *testing.T) {
+	src := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	src.addPerson(&internal.Person{ID: 7, Name: "Alice", Properties: map[string]string{"city": "Paris"}})
+	src.addPerson(&internal.Person{ID: 9, Name: "Bob"})
+	src.addFriendship(7, 9, internal.Family, 2)
+
+	for _, format := range []internal.ExportFormat{internal.GraphML, internal.CSV} {
+		var buf bytes.Buffer
+		if err := src.Export(context.Background(), format, internal.EdgeFilter{}, &buf); err != nil {
+			t.Fatal(err)
+		}
+		imp, err := importer.Read(&buf, internal.ImportFormat(format))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dst := NewGraphStore(nil, &seqStore{}, missCache{})
+		res, err := dst.Import(context.Background(), imp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Errors) != 0 {
+			t.Fatalf("%v: Got: %v Want: no errors", format, res.Errors)
+		}
+
+		friendship, err := dst.GetFriendship(context.Background(), res.IDs["7"], internal.EdgeFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := fmt.Sprint(friendship.P1.Name, friendship.P1.Properties, friendship.With, friendship.Weights, friendship.Types)
+		if want := fmt.Sprint("Alice", map[string]string{"city": "Paris"}, []int64{res.IDs["9"]}, []float64{2}, []internal.RelationType{internal.Family}); got != want {
+			t.Fatalf("%v: Got: %v Want: %v", format, got, want)
+		}
+	}
+}
+
+func TestImportInvalidCells(t *testing.T) {
+	nodes := "id,name\na,Alice\nb,Bob\nc,Carol\n"
+	edges := "source,target,weight,mutual\na,b,2,\na,c,heavy,\nb,c,,maybe\nc,a,,true\n"
+	fromCSV, err := importer.ReadCSV(strings.NewReader(nodes), strings.NewReader(edges))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graphML := `<graphml>
+	<key id="w" for="edge" attr.name="weight"/>
+	<key id="m" for="edge" attr.name="mutual"/>
+	<graph edgedefault="directed">
+		<node id="a"/><node id="b"/><node id="c"/>
+		<edge source="a" target="b"><data key="w">2</data></edge>
+		<edge source="a" target="c"><data key="w">heavy</data></edge>
+		<edge source="b" target="c"><data key="m">maybe</data></edge>
+		<edge source="c" target="a"><data key="m">true</data></edge>
+	</graph>
+</graphml>`
+	fromGraphML, err := importer.Read(strings.NewReader(graphML), internal.ImportGraphML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the rows of the bad cells are reported and skipped, the first row is the header in csv.
+	for _, tc := range []struct {
+		name string
+		imp  *internal.Import
+		want string
+	}{
+		{"csv", fromCSV, "[edge 3: invalid weight: heavy edge 4: invalid mutual: maybe]"},
+		{"graphml", fromGraphML, "[edge 2: invalid weight: heavy edge 3: invalid mutual: maybe]"},
+	} {
+		s := NewGraphStore(nil, &seqStore{}, missCache{})
+		res, err := s.Import(context.Background(), tc.imp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, rowErr := range res.Errors {
+			got = append(got, fmt.Sprintf("%v %v: %v", rowErr.Kind, rowErr.Row, rowErr.Error))
+		}
+		if fmt.Sprint(got) != tc.want {
+			t.Fatalf("%v: Got: %v Want: %v", tc.name, got, tc.want)
+		}
+		if got, want := len(res.Friendships), 2; got != want {
+			t.Fatalf("%v: Got: %v Want: %v", tc.name, got, want)
+		}
+	}
+}
+
+// failStore is a store failing every batch.
+type failStore struct {
+	noop.NoopStore
+}
+
+func (failStore) ApplyBatch(context.Context, []internal.Mutation) error {
+	return internal.Errorf(internal.EINTERNAL, "rollback")
+}
+
+func TestBatch(t *testing.T) {
+	s := NewGraphStore(nil, &seqStore{last: 10}, missCache{})
+	s.addPerson(&internal.Person{ID: 1, Name: "Bob"})
+	s.addPerson(&internal.Person{ID: 2, Name: "Carol"})
+	s.addFriendship(2, 1, internal.Friend, 1)
+	ctx := context.Background()
+
+	res, err := s.Batch(ctx, []internal.BatchOp{
+		{Op: internal.AddPersonOp, Ref: "alice", Person: &internal.Person{Name: "Alice"}},
+		{Op: internal.AddFriendshipOp, From: internal.PersonRef{Ref: "alice"}, To: internal.PersonRef{ID: 1}, Mutual: true},
+		{Op: internal.RemovePersonOp, Target: internal.PersonRef{ID: 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.IDs["alice"], int64(11); got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := fmt.Sprint(res.Results[1].Friendship.P1.ID, res.Results[1].Friendship.With), "11 [1]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	friendship, err := s.GetFriendship(ctx, 1, internal.EdgeFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(friendship.With), "[11]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if _, err := s.GetPerson(ctx, 2); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", err, internal.ENOTFOUND)
+	}
+
+	tests := []struct {
+		name string
+		ops  []internal.BatchOp
+		code internal.ECode
+	}{
+		{"unknown ref", []internal.BatchOp{
+			{Op: internal.AddFriendshipOp, From: internal.PersonRef{Ref: "dave"}, To: internal.PersonRef{ID: 1}},
+		}, internal.ENOTFOUND},
+		{"removed earlier", []internal.BatchOp{
+			{Op: internal.RemovePersonOp, Target: internal.PersonRef{ID: 1}},
+			{Op: internal.AddFriendshipOp, From: internal.PersonRef{ID: 11}, To: internal.PersonRef{ID: 1}},
+		}, internal.ENOTFOUND},
+		{"invalid person", []internal.BatchOp{
+			{Op: internal.AddPersonOp, Person: &internal.Person{}},
+		}, internal.EINVALID},
+		{"empty", nil, internal.EINVALID},
+	}
+	for _, test := range tests {
+		if _, err := s.Batch(ctx, test.ops); internal.ErrorCode(err) != test.code {
+			t.Fatalf("%v: Got: %v Want: %v", test.name, err, test.code)
+		}
+	}
+	if _, err := s.GetPerson(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// a failing store leaves the graph untouched.
+	s.repo = failStore{}
+	if _, err := s.Batch(ctx, []internal.BatchOp{
+		{Op: internal.AddPersonOp, Ref: "dave", Person: &internal.Person{Name: "Dave"}},
+		{Op: internal.RemovePersonOp, Target: internal.PersonRef{ID: 1}},
+	}); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := s.GetPerson(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetStats(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	for id := int64(1); id <= 7; id++ {
+		s.addPerson(&internal.Person{ID: id})
+	}
+	// triangle 1, 2, 3 with 1 and 2 mutual friends, chain 5 -> 6 -> 7 and 4 alone.
+	s.addFriendship(1, 2, internal.Friend, 1)
+	s.addFriendship(2, 1, internal.Friend, 1)
+	s.addFriendship(2, 3, internal.Friend, 1)
+	s.addFriendship(3, 1, internal.Colleague, 1)
+	s.addFriendship(3, 1, internal.Friend, 1)
+	s.addFriendship(5, 6, internal.Family, 1)
+	s.addFriendship(6, 7, internal.Family, 1)
+
+	stats, err := s.GetStats(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := fmt.Sprint(
+		stats.People, stats.Friendships, stats.Relationships, stats.Isolated,
+		stats.Components, stats.StrongComponents, stats.LargestComponent,
+		stats.Diameter, stats.Radius, stats.Estimated, stats.OutDegrees,
+	)
+	want := fmt.Sprint(
+		7, 6, map[internal.RelationType]int{internal.Friend: 4, internal.Colleague: 1, internal.Family: 2}, 1,
+		3, 5, 3,
+		1, 1, false, []internal.DegreeCount{{Degree: 0, People: 2}, {Degree: 1, People: 4}, {Degree: 2, People: 1}},
+	)
+	if got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if got, want := stats.Density, 6.0/42; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	// 1, 2 and 3 have a coefficient of 1, everyone else 0.
+	if got, want := stats.Clustering, 3.0/7; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestAsOf(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	base := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	at := func(day int) time.Time { return base.AddDate(0, 0, day) }
+	var now time.Time
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+	friendship := func(p1, p2 int64) internal.Friendship {
+		return internal.Friendship{P1: &internal.Person{ID: p1}, With: []int64{p2}}
+	}
+
+	for id := int64(1); id <= 3; id++ {
+		s.addPerson(&internal.Person{ID: id, CreatedAt: at(0)})
+	}
+	now = at(1)
+	if err := s.AddFriendship(ctx, friendship(1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	now = at(2)
+	if err := s.AddFriendship(ctx, friendship(2, 3)); err != nil {
+		t.Fatal(err)
+	}
+	now = at(3)
+	if err := s.RemoveFriendship(ctx, friendship(1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	now = at(4)
+	if err := s.RemovePerson(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		asOf  time.Time
+		with  string
+		depth int
+	}{
+		{at(1), "[2]", -1},
+		{at(2), "[2]", 3},
+		{at(3), "[]", -1},
+		{time.Time{}, "[]", -1}, // now.
+	}
+	for _, test := range tests {
+		filter := internal.EdgeFilter{AsOf: test.asOf}
+		res, err := s.GetFriendship(ctx, 1, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprint(res.With); got != test.with {
+			t.Fatalf("%v: Got: %v Want: %v", test.asOf, got, test.with)
+		}
+
+		depth, err := s.GetDepth(ctx, 1, 3, filter)
+		if test.depth == -1 {
+			if internal.ErrorCode(err) != internal.ENOTFOUND {
+				t.Fatalf("%v: Got: %v Want: %v", test.asOf, err, internal.ENOTFOUND)
+			}
+		} else if depth != test.depth {
+			t.Fatalf("%v: Got: %v Want: %v", test.asOf, depth, test.depth)
+		}
+	}
+
+	// 3 is part of the graph until removed, nobody existed before.
+	for asOf, want := range map[time.Time]int{at(-1): 0, at(3): 3, {}: 2} {
+		all, err := s.GetAll(ctx, internal.EdgeFilter{AsOf: asOf})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(all); got != want {
+			t.Fatalf("%v: Got: %v Want: %v", asOf, got, want)
+		}
+	}
+}
+
+// clockStore is a store recording mutations as stored at now.
+type clockStore struct {
+	noop.NoopStore
+	now time.Time
+}
+
+func (s *clockStore) ApplyBatch(_ context.Context, muts []internal.Mutation) error {
+	for i := range muts {
+		muts[i].At = s.now
+	}
+	return nil
+}
+
+func TestAsOfStoredTime(t *testing.T) {
+	base := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	at := func(day int) time.Time { return base.AddDate(0, 0, day) }
+	repo := &clockStore{}
+	s := NewGraphStore(nil, repo, missCache{})
+	s.now = func() time.Time { return at(10) } // the clock of the store differs.
+	ctx := context.Background()
+
+	for id := int64(1); id <= 3; id++ {
+		s.addPerson(&internal.Person{ID: id, CreatedAt: at(0)})
+	}
+	repo.now = at(1)
+	if err := s.AddFriendship(ctx, internal.Friendship{P1: &internal.Person{ID: 1}, With: []int64{2}, Mutual: true}); err != nil {
+		t.Fatal(err)
+	}
+	repo.now = at(2)
+	if err := s.AddFriendship(ctx, internal.Friendship{P1: &internal.Person{ID: 1}, With: []int64{3}}); err != nil {
+		t.Fatal(err)
+	}
+	repo.now = at(3)
+	if err := s.RemoveFriendship(ctx, internal.Friendship{P1: &internal.Person{ID: 1}, With: []int64{2}, Mutual: true}); err != nil {
+		t.Fatal(err)
+	}
+	repo.now = at(4)
+	if err := s.RemovePerson(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		asOf time.Time
+		with string
+	}{
+		{at(0), "[]"},
+		{at(1), "[2]"},
+		{at(2), "[2 3]"},
+		{at(3), "[3]"},
+		{at(4), "[]"},
+	} {
+		res, err := s.GetFriendship(ctx, 1, internal.EdgeFilter{AsOf: test.asOf})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprint(res.With); got != test.with {
+			t.Fatalf("%v: Got: %v Want: %v", test.asOf, got, test.with)
+		}
+	}
+
+	// the mutual friendship was stored both ways at the same time.
+	res, err := s.GetFriendship(ctx, 2, internal.EdgeFilter{AsOf: at(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(res.With), "[1]"; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	if _, err := s.GetFriendship(ctx, 3, internal.EdgeFilter{AsOf: at(4)}); internal.ErrorCode(err) != internal.ENOTFOUND {
+		t.Fatalf("Got: %v Want: %v", err, internal.ENOTFOUND)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	s := NewGraphStore(nil, noop.NewNoopStore(), missCache{})
+	s.addPerson(&internal.Person{ID: 1, Name: "Alice", Properties: map[string]string{"team": "infra", "age": "30"}})
+	s.addPerson(&internal.Person{ID: 2, Name: "Bob", Properties: map[string]string{"team": "infra", "age": "25"}})
+	s.addPerson(&internal.Person{ID: 3, Name: "Carol", Properties: map[string]string{"team": "web", "age": "41"}})
+	s.addPerson(&internal.Person{ID: 4, Name: "Dave", Properties: map[string]string{"team": "infra"}})
+	s.addPerson(&internal.Person{ID: 5, Name: "Eve"})
+	s.addFriendship(1, 2, internal.Friend, 1)
+	s.addFriendship(2, 1, internal.Friend, 1)
+	s.addFriendship(2, 3, internal.Friend, 1)
+	s.addFriendship(3, 4, internal.Colleague, 2)
+	s.addFriendship(1, 5, internal.Family, 1)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		query string
+		want  string // rows separated by ; and values by ,
+	}{
+		{`MATCH (a {name: "Alice"})-[:friend]->(b) RETURN b.name`, "Bob"},
+		{`MATCH (a {name: "Alice"})-[:friend|colleague*2..3]->(b) RETURN b`, "Carol (3);Dave (4)"},
+		{`match (a)-[r]->(b) where r.weight > 1 return a.name, r, b.name`, "Carol,3 -[colleague 2]-> 4,Dave"},
+		{`MATCH (a:Person {team: "infra"}) WHERE a.age >= 30 OR NOT a.name STARTS WITH "B" RETURN a.name`, "Alice;Dave"},
+		{`MATCH (a)<-[:family]-(b) RETURN a.name, b.name`, "Eve,Alice"},
+		{`MATCH (a)-[:friend]-(b) WHERE a.name = "Bob" RETURN b.name`, "Alice;Carol;Alice"},
+		{`MATCH (a)-[:friend]->(b)-[:friend]->(a) RETURN a.name, b.name LIMIT 1`, "Alice,Bob"},
+		{`MATCH (a) WHERE a.team CONTAINS "fr" AND a <> 3 RETURN a.id, a.age LIMIT 3`, "1,30;2,25;4,null"},
+	} {
+		res, err := s.Query(ctx, tc.query)
+		if err != nil {
+			t.Fatalf("%v: %v", tc.query, err)
+		}
+		rows := make([]string, len(res.Rows))
+		for i, row := range res.Rows {
+			values := make([]string, len(row))
+			for j, value := range row {
+				values[j] = value.String()
+			}
+			rows[i] = strings.Join(values, ",")
+		}
+		if got := strings.Join(rows, ";"); got != tc.want {
+			t.Fatalf("%v: Got: %v Want: %v", tc.query, got, tc.want)
+		}
+	}
+
+	for _, query := range []string{
+		`MATCH (a)-[*]->(b) RETURN b`,
+		`MATCH (a)-[:friend*1..7]->(b) RETURN b`,
+		`MATCH (a)-[r*1..2]->(b) RETURN r`,
+		`MATCH (a) RETURN b`,
+		`MATCH (a) RETURN a LIMIT 0`,
+		`MATCH (a:Company) RETURN a`,
+		`MATCH (a) WHERE a.name = RETURN a`,
+		`MATCH (a) WHERE a.name = "Alice RETURN a`,
+	} {
+		if _, err := s.Query(ctx, query); internal.ErrorCode(err) != internal.EINVALID {
+			t.Fatalf("%v: Got: %v Want: %v", query, err, internal.EINVALID)
+		}
+	}
+
+	// queries stop once ctx is done.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := s.Query(cancelled, `MATCH (a) RETURN a`); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Got: %v Want: %v", err, context.Canceled)
+	}
+}
+
+// benchmarkPairs picks connected pairs of people far away from each other.
+func TestQueryMissingPerson(t *testing.T) {
+	s := NewGraphStore(nil, nil, missCache{})
+	s.addPerson(&internal.Person{ID: 1, Name: "Alice"})
+	s.addPerson(&internal.Person{ID: 2, Name: "Bob"})
+	s.addFriendship(1, 2, internal.DefaultRelation, internal.DefaultWeight)
+	s.addFriendship(1, 99, internal.DefaultRelation, internal.DefaultWeight) // dangling, 99 isnt a person.
+
+	for q, want := range map[string]string{
+		"MATCH (a)-->(b) RETURN b.name":      "[[Bob]]",
+		"MATCH (a)<--(b) RETURN b.name":      "[[Alice]]",
+		"MATCH (a)-[*1..2]->(b) RETURN b.id": "[[2]]",
+	} {
+		res, err := s.Query(context.Background(), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprint(res.Rows); got != want {
+			t.Fatalf("%v: Got: %v Want: %v", q, got, want)
+		}
+	}
+}
+
+func benchmarkPairs(b *testing.B, s *GraphStoreService, n int) [][2]int64 {
+	rnd := rand.New(rand.NewSource(3))
+	pairs := make([][2]int64, 0, 16)
+	for len(pairs) < cap(pairs) {
+		first, second := rnd.Int63n(int64(n))+1, rnd.Int63n(int64(n))+1
+		path, err := s.getPath(context.Background(), first, second)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(path) > 3 {
+			pairs = append(pairs, [2]int64{first, second})
+		}
+	}
+	return pairs
+}
+
+func BenchmarkGetDepth(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		s := newRandomGraph(n, 4, 1)
+		pairs := benchmarkPairs(b, s, n)
+
+		b.Run(fmt.Sprintf("bidirectional-%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pair := pairs[i%len(pairs)]
+				if _, err := s.getDepth(context.Background(), pair[0], pair[1], internal.EdgeFilter{}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		// getPath runs a single ended bfs, used as the baseline.
+		b.Run(fmt.Sprintf("single-ended-%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pair := pairs[i%len(pairs)]
+				if _, err := s.getPath(context.Background(), pair[0], pair[1]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetPerson(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		s := newRandomGraph(n, 4, 1)
+
+		b.Run(fmt.Sprintf("indexed-%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := s.getPerson(int64(i%n) + 1); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		// scanning a slice of people is the previous node storage, used as the baseline.
+		people := make([]*internal.Person, 0, n)
+		for _, pers := range s.nodes {
+			people = append(people, pers)
+		}
+		b.Run(fmt.Sprintf("linear-scan-%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				id := int64(i%n) + 1
+				for _, pers := range people {
+					if pers.ID == id {
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+// sliceGraph is the previous storage of the graph, people and adjacency held in slices, used
+// as the baseline of the removal benchmarks.
+type sliceGraph struct {
+	nodes   []*internal.Person
+	edges   map[int64][]int64
+	inEdges map[int64][]int64
+}
+
+func newSliceGraph(s *GraphStoreService) *sliceGraph {
+	g := &sliceGraph{edges: make(map[int64][]int64), inEdges: make(map[int64][]int64)}
+	for _, id := range s.order {
+		g.nodes = append(g.nodes, s.nodes[id])
+		for friend := range s.edges[id] {
+			g.addFriendship(id, friend)
+		}
+	}
+	return g
+}
+
+func (g *sliceGraph) addFriendship(p1, p2 int64) {
+	g.edges[p1] = append(g.edges[p1], p2)
+	g.inEdges[p2] = append(g.inEdges[p2], p1)
+}
+
+func (g *sliceGraph) removeFriendship(p1, p2 int64) {
+	g.edges[p1] = removeID(g.edges[p1], p2)
+	g.inEdges[p2] = removeID(g.inEdges[p2], p1)
+}
+
+func (g *sliceGraph) removePerson(id int64) {
+	for _, friend := range append([]int64(nil), g.inEdges[id]...) {
+		g.removeFriendship(friend, id)
+	}
+	for _, friend := range append([]int64(nil), g.edges[id]...) {
+		g.removeFriendship(id, friend)
+	}
+	delete(g.edges, id)
+	delete(g.inEdges, id)
+	for i, pers := range g.nodes {
+		if pers.ID == id {
+			g.nodes[i] = g.nodes[len(g.nodes)-1]
+			g.nodes = g.nodes[:len(g.nodes)-1]
+			break
+		}
+	}
+}
+
+// removeID removes the first occurrence of id from ids without keeping order.
+func removeID(ids []int64, id int64) []int64 {
+	for i, j := range ids {
+		if j == id {
+			ids[i] = ids[len(ids)-1]
+			return ids[:len(ids)-1]
+		}
+	}
+	return ids
+}
+
+// BenchmarkRemoveFriendship unlinks and links back the friends of a person with degree
+// friends, ie: a celebrity once degree grows.
+func BenchmarkRemoveFriendship(b *testing.B) {
+	const n = 100000
+	for _, degree := range []int{10, 1000, 100000} {
+		s := newRandomGraph(n, 4, 1)
+		for i := 1; i <= degree; i++ {
+			s.addFriendship(1, int64(i), internal.DefaultRelation, internal.DefaultWeight)
+		}
+
+		b.Run(fmt.Sprintf("set-%v", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				friend := int64(i%degree) + 1
+				s.removeFriendship(1, friend, internal.DefaultRelation)
+				s.addFriendship(1, friend, internal.DefaultRelation, internal.DefaultWeight)
+			}
+		})
+
+		g := newSliceGraph(s)
+		b.Run(fmt.Sprintf("slice-%v", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				friend := int64(i%degree) + 1
+				g.removeFriendship(1, friend)
+				g.addFriendship(1, friend)
+			}
+		})
+	}
+}
+
+// BenchmarkRemovePerson removes people spread over the graph, linked with 4 friends each way,
+// adding them back untimed.
+func BenchmarkRemovePerson(b *testing.B) {
+	for _, n := range []int{1000, 100000} {
+		pick := func(i int) int64 { return int64(i*7919%n) + 1 }
+
+		s := newRandomGraph(n, 4, 1)
+		b.Run(fmt.Sprintf("set-%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				id := pick(i)
+				s.addPerson(&internal.Person{ID: id, Name: fmt.Sprint(id)})
+				for j := int64(1); j <= 4; j++ {
+					friend := (id+j*7)%int64(n) + 1
+					s.addFriendship(id, friend, internal.DefaultRelation, internal.DefaultWeight)
+					s.addFriendship(friend, id, internal.DefaultRelation, internal.DefaultWeight)
+				}
+				b.StartTimer()
+
+				s.removePerson(id)
+			}
+		})
+
+		g := newSliceGraph(newRandomGraph(n, 4, 1))
+		b.Run(fmt.Sprintf("slice-%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				id := pick(i)
+				g.removePerson(id) // present from the start, re-added at the end after.
+				g.nodes = append(g.nodes, &internal.Person{ID: id, Name: fmt.Sprint(id)})
+				for j := int64(1); j <= 4; j++ {
+					friend := (id+j*7)%int64(n) + 1
+					g.addFriendship(id, friend)
+					g.addFriendship(friend, id)
+				}
+				b.StartTimer()
+
+				g.removePerson(id)
+			}
+		})
+	}
+}
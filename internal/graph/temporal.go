@@ -0,0 +1,154 @@
+package graph
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// interval is the time a person or relationship was part of the graph, to is zero while
+// it still is.
+type interval struct {
+	from, to time.Time
+}
+
+// contains reports whether t is within the interval, from inclusive and to exclusive.
+func (i interval) contains(t time.Time) bool {
+	return !t.Before(i.from) && (i.to.IsZero() || t.Before(i.to))
+}
+
+// edgeKey identifies a relationship of a kind from p1 to p2.
+type edgeKey struct {
+	p1, p2 int64
+	kind   internal.RelationType
+}
+
+// edgeVersion is a relationship with the weight it had during the interval.
+type edgeVersion struct {
+	interval
+	weight float64
+}
+
+// personVersion is a person with the interval they were part of the graph.
+type personVersion struct {
+	interval
+	person *internal.Person
+}
+
+// timeline records the history of the graph, the live graph only holds what is valid now.
+//
+// the methods of a nil timeline do nothing, as of views dont need history.
+type timeline struct {
+	people map[int64]personVersion
+	edges  map[edgeKey][]edgeVersion // versions in chronological order.
+}
+
+func newTimeline() *timeline {
+	return &timeline{
+		people: make(map[int64]personVersion),
+		edges:  make(map[edgeKey][]edgeVersion),
+	}
+}
+
+// openPerson records p as part of the graph from at, people already part of it are left
+// untouched.
+func (t *timeline) openPerson(p *internal.Person, at time.Time) {
+	if t == nil {
+		return
+	}
+	if v, ok := t.people[p.ID]; ok && v.to.IsZero() {
+		return
+	}
+	t.people[p.ID] = personVersion{interval: interval{from: at}, person: p}
+}
+
+// closePerson records the person with id as removed at at.
+func (t *timeline) closePerson(id int64, at time.Time) {
+	if t == nil {
+		return
+	}
+	if v, ok := t.people[id]; ok && v.to.IsZero() {
+		v.to = at
+		t.people[id] = v
+	}
+}
+
+// openEdge records the relationship as valid from at, a relationship already valid with
+// the same weight is left untouched while a weight change starts a new version.
+func (t *timeline) openEdge(key edgeKey, weight float64, at time.Time) {
+	if t == nil {
+		return
+	}
+
+	versions := t.edges[key]
+	if n := len(versions); n != 0 && versions[n-1].to.IsZero() {
+		if versions[n-1].weight == weight {
+			return
+		}
+		versions[n-1].to = at
+	}
+	t.edges[key] = append(versions, edgeVersion{interval: interval{from: at}, weight: weight})
+}
+
+// closeEdge records the relationship as removed at at.
+func (t *timeline) closeEdge(key edgeKey, at time.Time) {
+	if t == nil {
+		return
+	}
+	if versions := t.edges[key]; len(versions) != 0 && versions[len(versions)-1].to.IsZero() {
+		versions[len(versions)-1].to = at
+	}
+}
+
+// addVersion records a relationship which was valid during a past or current interval,
+// used when loading the history from the persistent store.
+func (t *timeline) addVersion(key edgeKey, weight float64, i interval) {
+	if t == nil {
+		return
+	}
+	t.edges[key] = append(t.edges[key], edgeVersion{interval: i, weight: weight})
+}
+
+// view returns the store to run queries filtered by filter on, when filter has an AsOf time
+// a read only graph of the people and relationships valid at that time is built. Views only
+// hold the adjacency of the graph, without the indexes used to find people.
+func (s *GraphStoreService) view(filter internal.EdgeFilter) *GraphStoreService {
+	if filter.AsOf.IsZero() {
+		return s
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	view := &GraphStoreService{
+		cache:   s.cache,
+		now:     s.now,
+		nodes:   make(map[int64]*internal.Person),
+		edges:   make(map[int64]friends),
+		inEdges: make(map[int64]friends),
+	}
+	for id, v := range s.timeline.people {
+		if v.contains(filter.AsOf) {
+			view.nodes[id] = v.person
+			view.order = append(view.order, id)
+		}
+	}
+	sort.Slice(view.order, func(i, j int) bool { return view.order[i] < view.order[j] })
+
+	for key, versions := range s.timeline.edges {
+		if _, ok := view.nodes[key.p1]; !ok {
+			continue
+		}
+		if _, ok := view.nodes[key.p2]; !ok {
+			continue
+		}
+		for _, v := range versions {
+			if v.contains(filter.AsOf) {
+				view.addFriendship(key.p1, key.p2, key.kind, v.weight)
+				break
+			}
+		}
+	}
+	return view
+}
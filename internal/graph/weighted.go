@@ -0,0 +1,122 @@
+package graph
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// GetWeightedPath uses dijkstra to find the cheapest path between two people, each hop
+// costs the inverse of the friendship weight so strong friendships are preferred over
+// fewer hops. If the people arent related the path will be empty.
+//
+// returns ENOTFOUND if one of the people arent found.
+func (s *GraphStoreService) GetWeightedPath(ctx context.Context, first, second int64) (internal.WeightedPath, error) {
+	var res internal.WeightedPath
+
+	// check cache.
+	if err := s.cache.Get(ctx, fmt.Sprintf("W%v:%v", first, second), &res); err == nil {
+		return res, nil
+	}
+
+	// fetch path.
+	path, err := s.getWeightedPath(ctx, first, second)
+	if err != nil {
+		return path, err
+	}
+
+	if err := s.cache.Set(ctx, fmt.Sprintf("W%v:%v", first, second), path, 5*time.Second); err != nil {
+		return path, internal.WrapError(err, internal.EINTERNAL, "cache.Set") // wrap error easy to check for cache error.
+	}
+
+	return path, nil
+}
+
+func (s *GraphStoreService) getWeightedPath(ctx context.Context, first, target int64) (internal.WeightedPath, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	start, ok1 := s.nodes[first]
+	end, ok2 := s.nodes[target]
+	if !ok1 || !ok2 {
+		return internal.WeightedPath{}, internal.Errorf(internal.ENOTFOUND, "one of the ids provided doesent exist")
+	}
+	if first == target {
+		return internal.WeightedPath{Path: []*internal.Person{start}}, nil
+	}
+
+	dist := map[int64]float64{first: 0}
+	parents := map[int64]int64{first: first}
+	done := make(map[int64]bool)
+	queue := &distanceQueue{{id: first}}
+	for queue.Len() != 0 {
+		select {
+		case <-ctx.Done():
+			return internal.WeightedPath{}, internal.WrapError(ctx.Err(), internal.EINVALID, "ctx.Err")
+
+		default:
+		}
+
+		curr := heap.Pop(queue).(distanceItem)
+		if done[curr.id] {
+			continue // stale entry, a shorter distance was already settled.
+		}
+		done[curr.id] = true
+
+		if curr.id == target {
+			return internal.WeightedPath{
+				Distance: curr.dist,
+				Path:     s.buildPath(parents, first, end),
+			}, nil
+		}
+
+		for j, rels := range s.edges[curr.id] {
+			if done[j] {
+				continue
+			}
+
+			alt := curr.dist + 1/rels.weight()
+			if d, ok := dist[j]; ok && d <= alt {
+				continue
+			}
+			dist[j] = alt
+			parents[j] = curr.id
+			heap.Push(queue, distanceItem{id: j, dist: alt})
+		}
+	}
+
+	// not related.
+	return internal.WeightedPath{Path: []*internal.Person{}}, nil
+}
+
+// distanceItem is a person queued with their tentative distance from the source.
+type distanceItem struct {
+	id   int64
+	dist float64
+}
+
+// distanceQueue is a min heap of distanceItem ordered by distance.
+type distanceQueue []distanceItem
+
+func (q distanceQueue) Len() int { return len(q) }
+
+func (q distanceQueue) Less(i, j int) bool {
+	if q[i].dist == q[j].dist {
+		return q[i].id < q[j].id
+	}
+	return q[i].dist < q[j].dist
+}
+
+func (q distanceQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *distanceQueue) Push(x interface{}) { *q = append(*q, x.(distanceItem)) }
+
+func (q *distanceQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
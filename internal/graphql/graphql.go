@@ -0,0 +1,83 @@
+// Package graphql serves the graph over GraphQL next to the rest api: queries resolving
+// people and their friends in a single round trip, the mutations of the rest api and
+// subscriptions to the events published to the message broker.
+//
+// queries and mutations are sent as json to POST /graphql, subscriptions over a websocket
+// opened with GET /graphql using the graphql-transport-ws protocol.
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Lambels/relationer/internal/service"
+	"github.com/go-chi/chi/v5"
+	gql "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+const (
+	// DefaultMaxDepth is the default limit to the nesting of the fields of an operation.
+	DefaultMaxDepth = 8
+	// maxResolved bounds the number of friends resolved by a single query or mutation.
+	maxResolved = 10000
+)
+
+type HandlerService struct {
+	schema *gql.Schema
+}
+
+// NewHandlerService creates the GraphQL api over store, mutations publish to broker and
+// subscriptions read from events. maxDepth limits the nesting of the fields of operations,
+// ie: a person's friends of friends have a depth of 3.
+func NewHandlerService(store service.GraphStore, broker service.MessageBroker, events service.Subscriber, maxDepth int) *HandlerService {
+	res := &resolver{
+		store:  store,
+		broker: broker,
+		events: events,
+	}
+
+	return &HandlerService{
+		schema: gql.MustParseSchema(schema, res, gql.UseStringDescriptions(), gql.MaxDepth(maxDepth)),
+	}
+}
+
+func (h *HandlerService) RegisterRouter(mux chi.Router) {
+	mux.Get("/graphql", h.serveWebsocket)
+	mux.Post("/graphql", h.serveGraphQL)
+}
+
+// request is a GraphQL operation sent over http.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (h *HandlerService) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendResponse(w, errorResponse("invalid json body"), http.StatusBadRequest)
+		return
+	}
+
+	res := h.schema.Exec(withBudget(r.Context(), maxResolved), req.Query, req.OperationName, req.Variables)
+	sendResponse(w, res, http.StatusOK)
+}
+
+func errorResponse(msg string) *gql.Response {
+	return &gql.Response{Errors: []*gqlerrors.QueryError{{Message: msg}}}
+}
+
+func sendResponse(w http.ResponseWriter, res interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(status)
+	w.Write(payload)
+}
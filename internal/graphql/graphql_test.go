@@ -0,0 +1,264 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/graph"
+	noop "github.com/Lambels/relationer/internal/no-op"
+	"github.com/Lambels/relationer/internal/pubsub"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+)
+
+// seqStore is a store generating sequential ids.
+type seqStore struct {
+	noop.NoopStore
+	id int64
+}
+
+func (s *seqStore) AddPerson(_ context.Context, person *internal.Person) error {
+	s.id++
+	person.ID = s.id
+	return nil
+}
+
+// missCache is a cache which never holds any values.
+type missCache struct{}
+
+func (missCache) Set(context.Context, string, interface{}, time.Duration) error { return nil }
+func (missCache) Delete(context.Context, string) error                          { return nil }
+func (missCache) Get(context.Context, string, interface{}) error {
+	return errors.New("cache miss")
+}
+
+// newServer serves the GraphQL api over an empty in-memory graph.
+func newServer(t *testing.T) (*httptest.Server, *graph.GraphStoreService, *pubsub.Broker) {
+	t.Helper()
+	store := graph.NewGraphStore(nil, &seqStore{}, missCache{})
+	broker := pubsub.New(nil)
+
+	mux := chi.NewRouter()
+	NewHandlerService(store, broker, broker, DefaultMaxDepth).RegisterRouter(mux)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts, store, broker
+}
+
+// addPeople adds people with names, friends with the first of them.
+func addPeople(t *testing.T, store *graph.GraphStoreService, names ...string) []int64 {
+	t.Helper()
+	ids := make([]int64, len(names))
+	for i, name := range names {
+		person := &internal.Person{Name: name}
+		if err := store.AddPerson(context.Background(), person); err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = person.ID
+	}
+	for _, id := range ids[1:] {
+		if err := store.AddFriendship(context.Background(), internal.Friendship{P1: &internal.Person{ID: ids[0]}, With: []int64{id}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ids
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// post sends query to the server, decoding the response.
+func post(t *testing.T, ts *httptest.Server, query string) response {
+	t.Helper()
+	body, _ := json.Marshal(request{Query: query})
+	resp, err := http.Post(ts.URL+"/graphql", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var res response
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestQueryFriends(t *testing.T) {
+	ts, store, _ := newServer(t)
+	addPeople(t, store, "foo", "bar", "baz")
+	// bar is friends with foo, making foo a friend of their friend.
+	if err := store.AddFriendship(context.Background(), internal.Friendship{P1: &internal.Person{ID: 2}, With: []int64{1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	res := post(t, ts, `{ person(id: "1") { name friends { id name friends { name } } } }`)
+	if len(res.Errors) != 0 {
+		t.Fatal(res.Errors)
+	}
+
+	want := `{"person":{"name":"foo","friends":[{"id":"2","name":"bar","friends":[{"name":"foo"}]},{"id":"3","name":"baz","friends":[]}]}}`
+	if got := string(res.Data); got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+}
+
+func TestQueryDepth(t *testing.T) {
+	ts, store, _ := newServer(t)
+	addPeople(t, store, "foo", "bar", "baz")
+
+	for _, tc := range []struct {
+		query, want, err string
+	}{
+		{`{ depth(from: "1", to: "3") }`, `{"depth":2}`, ""},
+		// 3 has no friends, so isnt connected to 1.
+		{`{ depth(from: "3", to: "1") }`, `{"depth":null}`, ""},
+		{`{ depth(from: "1", to: "99") }`, `{"depth":null}`, "person not found"},
+	} {
+		res := post(t, ts, tc.query)
+		if got := string(res.Data); got != tc.want {
+			t.Fatalf("%v: Got: %v Want: %v", tc.query, got, tc.want)
+		}
+		switch {
+		case tc.err == "" && len(res.Errors) != 0:
+			t.Fatalf("%v: %v", tc.query, res.Errors)
+		case tc.err != "" && (len(res.Errors) == 0 || !strings.Contains(res.Errors[0].Message, tc.err)):
+			t.Fatalf("%v: Got: %v Want: %v", tc.query, res.Errors, tc.err)
+		}
+	}
+}
+
+func TestQueryLimits(t *testing.T) {
+	ts, store, _ := newServer(t)
+
+	// nesting friends past DefaultMaxDepth is rejected before resolving anything.
+	query := `{ person(id: "1") { ` + strings.Repeat("friends { ", DefaultMaxDepth) + "id" + strings.Repeat(" }", DefaultMaxDepth) + " } }"
+	res := post(t, ts, query)
+	if len(res.Errors) == 0 || !strings.Contains(res.Errors[0].Message, "exceeds max depth") {
+		t.Fatalf("expected max depth error, got: %v", res.Errors)
+	}
+
+	names := make([]string, maxResolved+2)
+	for i := range names {
+		names[i] = "foo"
+	}
+	addPeople(t, store, names...)
+
+	// only the friends returned are taken out of the budget.
+	res = post(t, ts, `{ person(id: "1") { friends(first: 1) { id } } }`)
+	if len(res.Errors) != 0 {
+		t.Fatal(res.Errors)
+	}
+	if got, want := string(res.Data), `{"person":{"friends":[{"id":"2"}]}}`; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	res = post(t, ts, fmt.Sprintf(`{ person(id: "1") { friends(first: %v) { id } } }`, maxResolved+1))
+	if len(res.Errors) == 0 || !strings.Contains(res.Errors[0].Message, "too many people") {
+		t.Fatalf("expected budget error, got: %v", res.Errors)
+	}
+
+	res = post(t, ts, `{ person(id: "1") { friends(first: -1) { id } } }`)
+	if len(res.Errors) == 0 || !strings.Contains(res.Errors[0].Message, "first must be positive") {
+		t.Fatalf("expected invalid first error, got: %v", res.Errors)
+	}
+}
+
+func TestMutationPublishes(t *testing.T) {
+	ts, _, broker := newServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := broker.Subscribe(ctx)
+
+	res := post(t, ts, `mutation { addPerson(name: "foo", properties: [{key: "city", value: "paris"}]) { id name } }`)
+	if len(res.Errors) != 0 {
+		t.Fatal(res.Errors)
+	}
+	if got, want := string(res.Data), `{"addPerson":{"id":"1","name":"foo"}}`; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	select {
+	case event := <-events:
+		if event.Key != internal.PersonCreated || event.Person.ID != 1 || event.Person.Properties["city"] != "paris" {
+			t.Fatalf("unexpected event: %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected person created event")
+	}
+}
+
+// read reads the next message of conn, failing the test past a second.
+func read(t *testing.T, conn *websocket.Conn) message {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	var msg message
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func expect(t *testing.T, msg message, id, typ string) {
+	t.Helper()
+	if msg.ID != id || msg.Type != typ {
+		t.Fatalf("Got: %v %v Want: %v %v", msg.ID, msg.Type, id, typ)
+	}
+}
+
+func TestWebsocket(t *testing.T) {
+	ts, _, _ := newServer(t)
+
+	dialer := websocket.Dialer{Subprotocols: []string{subprotocol}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/graphql", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	conn.WriteJSON(message{Type: msgConnectionInit})
+	expect(t, read(t, conn), "", msgConnectionAck)
+
+	payload := marshal(request{Query: `subscription { personCreated { id name } }`})
+	conn.WriteJSON(message{ID: "1", Type: msgSubscribe, Payload: payload})
+	// messages are handled in order, once ponged the subscription is listening.
+	conn.WriteJSON(message{Type: msgPing})
+	expect(t, read(t, conn), "", msgPong)
+
+	res := post(t, ts, `mutation { addPerson(name: "foo") { id } }`)
+	if len(res.Errors) != 0 {
+		t.Fatal(res.Errors)
+	}
+
+	msg := read(t, conn)
+	expect(t, msg, "1", msgNext)
+	if got, want := string(msg.Payload), `{"data":{"personCreated":{"id":"1","name":"foo"}}}`; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+
+	// subscriptions completed by the client arent completed back, queries complete after
+	// their result.
+	conn.WriteJSON(message{ID: "1", Type: msgComplete})
+	payload = marshal(request{Query: `{ person(id: "1") { name } }`})
+	conn.WriteJSON(message{ID: "2", Type: msgSubscribe, Payload: payload})
+
+	msg = read(t, conn)
+	expect(t, msg, "2", msgNext)
+	if got, want := string(msg.Payload), `{"data":{"person":{"name":"foo"}}}`; got != want {
+		t.Fatalf("Got: %v Want: %v", got, want)
+	}
+	expect(t, read(t, conn), "2", msgComplete)
+}
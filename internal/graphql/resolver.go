@@ -0,0 +1,494 @@
+package graphql
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/service"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// resolver is the root resolver of the query, mutation and subscription fields.
+type resolver struct {
+	store  service.GraphStore
+	broker service.MessageBroker
+	events service.Subscriber
+}
+
+type budgetKey struct{}
+
+// withBudget limits the number of friends resolved by the operations run with ctx to n.
+func withBudget(ctx context.Context, n int64) context.Context {
+	return context.WithValue(ctx, budgetKey{}, &n)
+}
+
+// spend takes n people out of the budget of ctx, operations without a budget (subscriptions)
+// are only bound by the depth limit.
+func spend(ctx context.Context, n int) error {
+	budget, ok := ctx.Value(budgetKey{}).(*int64)
+	if !ok {
+		return nil
+	}
+	if atomic.AddInt64(budget, -int64(n)) < 0 {
+		return internal.Errorf(internal.EINVALID, "query resolves too many people, lower the depth or the first arguments")
+	}
+	return nil
+}
+
+func parseID(id gql.ID) (int64, error) {
+	n, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return 0, internal.Errorf(internal.EINVALID, "invalid id: %v", id)
+	}
+	return n, nil
+}
+
+func toID(id int64) gql.ID {
+	return gql.ID(strconv.FormatInt(id, 10))
+}
+
+func parseFilter(types *[]string) (internal.EdgeFilter, error) {
+	var filter internal.EdgeFilter
+	if types != nil {
+		for _, t := range *types {
+			filter.Types = append(filter.Types, internal.RelationType(t))
+		}
+	}
+	return filter, filter.Validate()
+}
+
+type propertyInput struct {
+	Key   string
+	Value string
+}
+
+func properties(in *[]propertyInput) []internal.Property {
+	if in == nil {
+		return nil
+	}
+	props := make([]internal.Property, len(*in))
+	for i, prop := range *in {
+		props[i] = internal.Property{Key: prop.Key, Value: prop.Value}
+	}
+	return props
+}
+
+func (r *resolver) people(people []*internal.Person) []*personResolver {
+	res := make([]*personResolver, len(people))
+	for i, person := range people {
+		res[i] = &personResolver{r: r, person: person}
+	}
+	return res
+}
+
+func (r *resolver) Person(ctx context.Context, args struct{ ID gql.ID }) (*personResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	person, err := r.store.GetPerson(ctx, id)
+	if internal.ErrorCode(err) == internal.ENOTFOUND {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &personResolver{r: r, person: person}, nil
+}
+
+func (r *resolver) People(ctx context.Context, args struct{ Where *[]propertyInput }) ([]*personResolver, error) {
+	people, err := r.store.FindPeople(ctx, properties(args.Where))
+	if err != nil {
+		return nil, err
+	}
+	return r.people(people), nil
+}
+
+func (r *resolver) Search(ctx context.Context, args struct {
+	Query string
+	Limit int32
+}) ([]*personResolver, error) {
+	results, err := r.store.SearchPeople(ctx, args.Query, int(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*personResolver, len(results))
+	for i, result := range results {
+		res[i] = &personResolver{r: r, person: result.Person}
+	}
+	return res, nil
+}
+
+type pairArgs struct {
+	From gql.ID
+	To   gql.ID
+}
+
+func (args pairArgs) parse() (int64, int64, error) {
+	from, err := parseID(args.From)
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := parseID(args.To)
+	return from, to, err
+}
+
+func (r *resolver) Path(ctx context.Context, args pairArgs) ([]*personResolver, error) {
+	from, to, err := args.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := r.store.GetPath(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return r.people(path), nil
+}
+
+func (r *resolver) Depth(ctx context.Context, args struct {
+	pairArgs
+	Types *[]string
+}) (*int32, error) {
+	from, to, err := args.parse()
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseFilter(args.Types)
+	if err != nil {
+		return nil, err
+	}
+	// unknown people are errors, unlike people who arent connected.
+	for _, id := range []int64{from, to} {
+		if _, err := r.store.GetPerson(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	depth, err := r.store.GetDepth(ctx, from, to, filter)
+	if internal.ErrorCode(err) == internal.ENOTFOUND {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	res := int32(depth)
+	return &res, nil
+}
+
+func (r *resolver) AddPerson(ctx context.Context, args struct {
+	Name       string
+	Properties *[]propertyInput
+}) (*personResolver, error) {
+	person := &internal.Person{Name: args.Name}
+	if props := properties(args.Properties); len(props) != 0 {
+		person.Properties = make(map[string]string, len(props))
+		for _, prop := range props {
+			person.Properties[prop.Key] = prop.Value
+		}
+	}
+	if err := person.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := r.store.AddPerson(ctx, person); err != nil {
+		return nil, err
+	}
+	if err := r.broker.CreatedPerson(ctx, person); err != nil {
+		return nil, err
+	}
+	return &personResolver{r: r, person: person}, nil
+}
+
+type friendshipArgs struct {
+	pairArgs
+	Weight *float64
+	Type   *string
+	Mutual bool
+}
+
+// friendship builds the friendship described by args, with the default weight and type.
+func (args friendshipArgs) friendship() (internal.Friendship, error) {
+	from, to, err := args.parse()
+	if err != nil {
+		return internal.Friendship{}, err
+	}
+
+	friendship := internal.Friendship{P1: &internal.Person{ID: from}, With: []int64{to}, Mutual: args.Mutual}
+	if args.Weight != nil {
+		friendship.Weights = []float64{*args.Weight}
+	}
+	if args.Type != nil {
+		friendship.Types = []internal.RelationType{internal.RelationType(*args.Type)}
+	}
+	return friendship.WithDefaults(), nil
+}
+
+func (r *resolver) AddFriendship(ctx context.Context, args friendshipArgs) (*friendshipResolver, error) {
+	friendship, err := args.friendship()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.store.AddFriendship(ctx, friendship); err != nil {
+		return nil, err
+	}
+	if err := r.broker.CreatedFriendship(ctx, friendship); err != nil {
+		return nil, err
+	}
+	return r.friendship(friendship), nil
+}
+
+func (r *resolver) RemoveFriendship(ctx context.Context, args struct {
+	pairArgs
+	Type   *string
+	Mutual bool
+}) (bool, error) {
+	friendship, err := friendshipArgs{pairArgs: args.pairArgs, Type: args.Type, Mutual: args.Mutual}.friendship()
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.store.RemoveFriendship(ctx, friendship); err != nil {
+		return false, err
+	}
+	if err := r.broker.DeletedFriendship(ctx, friendship); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *resolver) RemovePerson(ctx context.Context, args struct{ ID gql.ID }) (bool, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.store.RemovePerson(ctx, id); err != nil {
+		return false, err
+	}
+	if err := r.broker.DeletedPerson(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// subscribe forwards the events published under key, converted by convert, until ctx is done.
+// The events are subscribed to before returning, so none published afterwards are missed.
+func subscribe[T any](ctx context.Context, events service.Subscriber, key string, convert func(internal.Event) T) <-chan T {
+	published := events.Subscribe(ctx)
+	res := make(chan T)
+	go func() {
+		defer close(res)
+		for event := range published {
+			if event.Key != key {
+				continue
+			}
+			select {
+			case res <- convert(event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res
+}
+
+func (r *resolver) PersonCreated(ctx context.Context) <-chan *personResolver {
+	return subscribe(ctx, r.events, internal.PersonCreated, func(event internal.Event) *personResolver {
+		return &personResolver{r: r, person: event.Person}
+	})
+}
+
+func (r *resolver) PersonDeleted(ctx context.Context) <-chan gql.ID {
+	return subscribe(ctx, r.events, internal.PersonDeleted, func(event internal.Event) gql.ID {
+		return toID(event.ID)
+	})
+}
+
+func (r *resolver) FriendshipCreated(ctx context.Context) <-chan *friendshipResolver {
+	return subscribe(ctx, r.events, internal.FriendshipCreated, func(event internal.Event) *friendshipResolver {
+		return r.friendship(*event.Friendship)
+	})
+}
+
+func (r *resolver) FriendshipDeleted(ctx context.Context) <-chan *friendshipResolver {
+	return subscribe(ctx, r.events, internal.FriendshipDeleted, func(event internal.Event) *friendshipResolver {
+		return r.friendship(*event.Friendship)
+	})
+}
+
+// friendship resolves the first relationship of friendship, as held by mutations and events.
+func (r *resolver) friendship(friendship internal.Friendship) *friendshipResolver {
+	return &friendshipResolver{
+		r:      r,
+		from:   friendship.P1,
+		to:     &internal.Person{ID: friendship.With[0]},
+		kind:   friendship.Types[0],
+		weight: friendship.Weights[0],
+		mutual: friendship.Mutual,
+	}
+}
+
+type personResolver struct {
+	r      *resolver
+	person *internal.Person
+}
+
+func (p *personResolver) ID() gql.ID {
+	return toID(p.person.ID)
+}
+
+func (p *personResolver) Name() string {
+	return p.person.Name
+}
+
+func (p *personResolver) CreatedAt() gql.Time {
+	return gql.Time{Time: p.person.CreatedAt}
+}
+
+func (p *personResolver) Properties() []*propertyResolver {
+	keys := make([]string, 0, len(p.person.Properties))
+	for key := range p.person.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := make([]*propertyResolver, len(keys))
+	for i, key := range keys {
+		res[i] = &propertyResolver{internal.Property{Key: key, Value: p.person.Properties[key]}}
+	}
+	return res
+}
+
+type relationArgs struct {
+	Types *[]string
+	First int32
+}
+
+// relations gets the friendship of the person, validating args.
+func (p *personResolver) relations(ctx context.Context, args relationArgs) (internal.Friendship, error) {
+	filter, err := parseFilter(args.Types)
+	if err != nil {
+		return internal.Friendship{}, err
+	}
+	if args.First < 0 {
+		return internal.Friendship{}, internal.Errorf(internal.EINVALID, "first must be positive")
+	}
+	return p.r.store.GetFriendship(ctx, p.person.ID, filter)
+}
+
+func (p *personResolver) Friends(ctx context.Context, args relationArgs) ([]*personResolver, error) {
+	friendship, err := p.relations(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// people related by many types are listed once.
+	ids := make([]int64, 0)
+	seen := make(map[int64]bool)
+	for _, id := range friendship.With {
+		if len(ids) == int(args.First) {
+			break
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if err := spend(ctx, len(ids)); err != nil {
+		return nil, err
+	}
+
+	res := make([]*personResolver, len(ids))
+	for i, id := range ids {
+		person, err := p.r.store.GetPerson(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = &personResolver{r: p.r, person: person}
+	}
+	return res, nil
+}
+
+func (p *personResolver) Friendships(ctx context.Context, args relationArgs) ([]*friendshipResolver, error) {
+	friendship, err := p.relations(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if n := int(args.First); len(friendship.With) > n {
+		friendship.With = friendship.With[:n]
+		friendship.Weights = friendship.Weights[:n]
+		friendship.Types = friendship.Types[:n]
+	}
+	if err := spend(ctx, len(friendship.With)); err != nil {
+		return nil, err
+	}
+
+	res := make([]*friendshipResolver, len(friendship.With))
+	for i, id := range friendship.With {
+		res[i] = &friendshipResolver{
+			r:      p.r,
+			from:   p.person,
+			to:     &internal.Person{ID: id},
+			kind:   friendship.Types[i],
+			weight: friendship.Weights[i],
+		}
+	}
+	return res, nil
+}
+
+type friendshipResolver struct {
+	r        *resolver
+	from, to *internal.Person
+	kind     internal.RelationType
+	weight   float64
+	mutual   bool
+}
+
+// person resolves the person from the store, falling back to the person held by the
+// friendship once removed (ie: friendships of a deleted person).
+func (f *friendshipResolver) person(ctx context.Context, held *internal.Person) (*personResolver, error) {
+	person, err := f.r.store.GetPerson(ctx, held.ID)
+	if internal.ErrorCode(err) == internal.ENOTFOUND {
+		person = held
+	} else if err != nil {
+		return nil, err
+	}
+	return &personResolver{r: f.r, person: person}, nil
+}
+
+func (f *friendshipResolver) From(ctx context.Context) (*personResolver, error) {
+	return f.person(ctx, f.from)
+}
+
+func (f *friendshipResolver) To(ctx context.Context) (*personResolver, error) {
+	return f.person(ctx, f.to)
+}
+
+func (f *friendshipResolver) Type() string {
+	return string(f.kind)
+}
+
+func (f *friendshipResolver) Weight() float64 {
+	return f.weight
+}
+
+func (f *friendshipResolver) Mutual() bool {
+	return f.mutual
+}
+
+type propertyResolver struct {
+	prop internal.Property
+}
+
+func (p *propertyResolver) Key() string {
+	return p.prop.Key
+}
+
+func (p *propertyResolver) Value() string {
+	return p.prop.Value
+}
@@ -0,0 +1,69 @@
+package graphql
+
+// schema is the GraphQL schema served, descriptions are read by introspection.
+const schema = `
+schema {
+	query: Query
+	mutation: Mutation
+	subscription: Subscription
+}
+
+scalar Time
+
+type Query {
+	"The person with id, null when not found."
+	person(id: ID!): Person
+	"The people having every property in where, ordered by id."
+	people(where: [PropertyInput!]): [Person!]!
+	"The people whose names match query, best first."
+	search(query: String!, limit: Int = 10): [Person!]!
+	"The shortest path from one person to another, endpoints included. Empty when they arent connected."
+	path(from: ID!, to: ID!): [Person!]!
+	"The number of people on the shortest path from one person to another, null when they arent connected and an error when one of them isnt found."
+	depth(from: ID!, to: ID!, types: [String!]): Int
+}
+
+type Mutation {
+	addPerson(name: String!, properties: [PropertyInput!]): Person!
+	"Adds a friendship, mutual friendships are added in both directions."
+	addFriendship(from: ID!, to: ID!, weight: Float, type: String, mutual: Boolean = false): Friendship!
+	removeFriendship(from: ID!, to: ID!, type: String, mutual: Boolean = false): Boolean!
+	removePerson(id: ID!): Boolean!
+}
+
+type Subscription {
+	personCreated: Person!
+	personDeleted: ID!
+	friendshipCreated: Friendship!
+	friendshipDeleted: Friendship!
+}
+
+type Person {
+	id: ID!
+	name: String!
+	createdAt: Time!
+	properties: [Property!]!
+	"The people this person has a friendship with, ordered by id."
+	friends(types: [String!], first: Int = 100): [Person!]!
+	"The friendships from this person, ordered by person then type."
+	friendships(types: [String!], first: Int = 100): [Friendship!]!
+}
+
+type Friendship {
+	from: Person!
+	to: Person!
+	type: String!
+	weight: Float!
+	mutual: Boolean!
+}
+
+type Property {
+	key: String!
+	value: String!
+}
+
+input PropertyInput {
+	key: String!
+	value: String!
+}
+`
@@ -0,0 +1,210 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// subprotocol is the websocket subprotocol spoken, described by:
+// https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const subprotocol = "graphql-transport-ws"
+
+// graphql-transport-ws message types.
+const (
+	msgConnectionInit = "connection_init"
+	msgConnectionAck  = "connection_ack"
+	msgPing           = "ping"
+	msgPong           = "pong"
+	msgSubscribe      = "subscribe"
+	msgNext           = "next"
+	msgError          = "error"
+	msgComplete       = "complete"
+)
+
+const (
+	// initTimeout is the time clients have to send connection_init once connected.
+	initTimeout = 10 * time.Second
+	// maxMessageSize bounds the size of the messages read from clients.
+	maxMessageSize = 1 << 20
+)
+
+var upgrader = websocket.Upgrader{
+	Subprotocols: []string{subprotocol},
+}
+
+type message struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func (h *HandlerService) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // the upgrader replied with the error.
+	}
+
+	s := &session{
+		schema: h.schema,
+		conn:   conn,
+		subs:   make(map[string]context.CancelFunc),
+	}
+	if conn.Subprotocol() != subprotocol {
+		s.close(4406, "Subprotocol not acceptable")
+		return
+	}
+	s.run(r.Context())
+}
+
+// session is a websocket connection running the operations of a client, each under the id
+// chosen by the client.
+type session struct {
+	schema *gql.Schema
+	conn   *websocket.Conn
+
+	wmu sync.Mutex // serialises writes.
+
+	mu   sync.Mutex
+	subs map[string]context.CancelFunc
+}
+
+// run reads the messages of the client until the connection is closed, the operations
+// running are stopped on return.
+func (s *session) run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer s.conn.Close()
+
+	s.conn.SetReadLimit(maxMessageSize)
+	s.conn.SetReadDeadline(time.Now().Add(initTimeout))
+	acked := false
+	for {
+		var msg message
+		if err := s.conn.ReadJSON(&msg); err != nil {
+			if !acked {
+				s.close(4408, "Connection initialisation timeout")
+			}
+			return
+		}
+
+		switch msg.Type {
+		case msgConnectionInit:
+			if acked {
+				s.close(4429, "Too many initialisation requests")
+				return
+			}
+			acked = true
+			s.conn.SetReadDeadline(time.Time{})
+			s.write(message{Type: msgConnectionAck})
+
+		case msgPing:
+			s.write(message{Type: msgPong})
+
+		case msgPong:
+
+		case msgSubscribe:
+			if !acked {
+				s.close(4401, "Unauthorized")
+				return
+			}
+			var req request
+			if err := json.Unmarshal(msg.Payload, &req); err != nil || msg.ID == "" {
+				s.close(4400, "Invalid subscribe message")
+				return
+			}
+			if !s.subscribe(ctx, msg.ID, req) {
+				s.close(4409, "Subscriber for "+msg.ID+" already exists")
+				return
+			}
+
+		case msgComplete:
+			s.remove(msg.ID)
+
+		default:
+			s.close(4400, "Invalid message type")
+			return
+		}
+	}
+}
+
+// subscribe runs the operation req under id, reporting false if id is already in use.
+// Queries and mutations complete after their single result, subscriptions once the client
+// completes them.
+func (s *session) subscribe(ctx context.Context, id string, req request) bool {
+	s.mu.Lock()
+	if _, ok := s.subs[id]; ok {
+		s.mu.Unlock()
+		return false
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.subs[id] = cancel
+	s.mu.Unlock()
+
+	responses, err := s.schema.Subscribe(ctx, req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		s.remove(id)
+		s.write(message{ID: id, Type: msgError, Payload: marshal(errorResponse(err.Error()).Errors)})
+		return true
+	}
+
+	go func() {
+		defer cancel()
+		for res := range responses {
+			res := res.(*gql.Response)
+			// operations failing validation only carry errors.
+			if res.Data == nil && len(res.Errors) != 0 {
+				if s.remove(id) {
+					s.write(message{ID: id, Type: msgError, Payload: marshal(res.Errors)})
+				}
+				return
+			}
+			s.write(message{ID: id, Type: msgNext, Payload: marshal(res)})
+		}
+
+		// operations completed by the client arent completed again.
+		if s.remove(id) {
+			s.write(message{ID: id, Type: msgComplete})
+		}
+	}()
+	return true
+}
+
+// remove stops the operation with id, reporting whether it was running.
+func (s *session) remove(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cancel, ok := s.subs[id]
+	if ok {
+		cancel()
+		delete(s.subs, id)
+	}
+	return ok
+}
+
+func (s *session) write(msg message) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	s.conn.WriteJSON(msg) // failed writes surface as read errors, closing the session.
+}
+
+func (s *session) close(code int, reason string) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	s.conn.Close()
+}
+
+// marshal encodes the responses and errors of the schema, which always encode.
+func marshal(v interface{}) json.RawMessage {
+	payload, _ := json.Marshal(v)
+	return payload
+}
@@ -0,0 +1,208 @@
+package internal
+
+import "fmt"
+
+// ImportFormat represents the file format the graph is imported from.
+type ImportFormat string
+
+// Import formats.
+const (
+	// ImportJSON imports an Import encoded as json.
+	ImportJSON ImportFormat = "json"
+	// ImportGraphML imports GraphML xml, the name, weight, type and mutual attributes are
+	// read and any other node attribute becomes a property.
+	ImportGraphML ImportFormat = "graphml"
+	// ImportCSV imports a zip archive holding a nodes.csv and an edges.csv file.
+	ImportCSV ImportFormat = "csv"
+)
+
+func (f ImportFormat) Validate() error {
+	switch f {
+	case ImportJSON, ImportGraphML, ImportCSV:
+		return nil
+	}
+	return Errorf(EINVALID, "unknown import format: %v", f)
+}
+
+// Import is a set of people and friendships to add at once.
+//
+// nodes are identified by external ids (ie: the ids of another system), edges reference
+// nodes of the same import by these ids.
+type Import struct {
+	Nodes []ImportNode `json:"nodes"`
+	Edges []ImportEdge `json:"edges"`
+	// Errors holds the rows of the source file which couldnt be decoded, reported with the
+	// result of the import.
+	Errors []ImportError `json:"-"`
+}
+
+// ImportNode is a person to import.
+type ImportNode struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Properties map[string]string `json:"properties,omitempty"`
+	Row        int               `json:"row,omitempty"` // row in the source file, the index when 0.
+}
+
+// ImportEdge is a friendship to import, from the node with id Source to the node with id Target.
+type ImportEdge struct {
+	Source string       `json:"source"`
+	Target string       `json:"target"`
+	Weight float64      `json:"weight,omitempty"`
+	Type   RelationType `json:"type,omitempty"`
+	Mutual bool         `json:"mutual,omitempty"`
+	Row    int          `json:"row,omitempty"` // row in the source file, the index when 0.
+}
+
+// ImportError reports a row which couldnt be imported.
+type ImportError struct {
+	Row   int    `json:"row"`
+	Kind  string `json:"kind"` // node or edge.
+	Error string `json:"error"`
+}
+
+// ImportResult holds the people and friendships created by an import.
+type ImportResult struct {
+	People      []*Person        `json:"people"`
+	Friendships []Friendship     `json:"friendships"`
+	IDs         map[string]int64 `json:"ids"` // external node id to generated person id.
+	Errors      []ImportError    `json:"errors"`
+}
+
+// Link is a friendship between two people which might not be stored yet, the ids of the
+// people are only read when converting to a friendship.
+type Link struct {
+	From   *Person
+	To     *Person
+	Weight float64
+	Type   RelationType
+	Mutual bool
+}
+
+// Friendship returns the friendship from From to To.
+func (l Link) Friendship() Friendship {
+	return Friendship{
+		P1:      l.From,
+		With:    []int64{l.To.ID},
+		Weights: []float64{l.Weight},
+		Types:   []RelationType{l.Type},
+		Mutual:  l.Mutual,
+	}
+}
+
+// ResolvedImport holds the valid rows of an import, ready to be stored.
+type ResolvedImport struct {
+	People []*Person
+	Links  []Link
+	Refs   map[string]*Person // external node id to person.
+	Errors []ImportError
+}
+
+// Result returns the result of the import, should be called once the people are stored
+// and have their ids.
+func (r ResolvedImport) Result() ImportResult {
+	res := ImportResult{
+		People:      r.People,
+		Friendships: make([]Friendship, len(r.Links)),
+		IDs:         make(map[string]int64, len(r.Refs)),
+		Errors:      r.Errors,
+	}
+	for i, link := range r.Links {
+		res.Friendships[i] = link.Friendship()
+	}
+	for ref, person := range r.Refs {
+		res.IDs[ref] = person.ID
+	}
+	return res
+}
+
+// Resolve validates the rows of the import and maps the external ids of the nodes to the
+// people to create.
+//
+// invalid rows are skipped and reported after the rows which couldnt be decoded, so are edges
+// referencing skipped nodes.
+func (imp *Import) Resolve() ResolvedImport {
+	people := make([]*Person, 0, len(imp.Nodes))
+	links := make([]Link, 0, len(imp.Edges))
+	errs := append(make([]ImportError, 0, len(imp.Errors)), imp.Errors...)
+	reject := func(row int, kind, format string, a ...interface{}) {
+		errs = append(errs, ImportError{Row: row, Kind: kind, Error: fmt.Sprintf(format, a...)})
+	}
+
+	byID := make(map[string]*Person, len(imp.Nodes))
+	for i, node := range imp.Nodes {
+		row := node.Row
+		if row == 0 {
+			row = i + 1
+		}
+
+		if node.ID == "" {
+			reject(row, "node", "id is a required field")
+			continue
+		}
+		if _, ok := byID[node.ID]; ok {
+			reject(row, "node", "duplicate node id: %v", node.ID)
+			continue
+		}
+		person := &Person{Name: node.Name, Properties: node.Properties}
+		if err := person.Validate(); err != nil {
+			reject(row, "node", "%v", err)
+			continue
+		}
+
+		byID[node.ID] = person
+		people = append(people, person)
+	}
+
+	type key struct {
+		from, to *Person
+		kind     RelationType
+	}
+	seen := make(map[key]bool, len(imp.Edges))
+	for i, edge := range imp.Edges {
+		row := edge.Row
+		if row == 0 {
+			row = i + 1
+		}
+
+		from, ok := byID[edge.Source]
+		if !ok {
+			reject(row, "edge", "unknown source node: %v", edge.Source)
+			continue
+		}
+		to, ok := byID[edge.Target]
+		if !ok {
+			reject(row, "edge", "unknown target node: %v", edge.Target)
+			continue
+		}
+
+		link := Link{From: from, To: to, Weight: edge.Weight, Type: edge.Type, Mutual: edge.Mutual}
+		if link.Weight == 0 {
+			link.Weight = DefaultWeight
+		}
+		if link.Type == "" {
+			link.Type = DefaultRelation
+		}
+		if link.Weight < 0 {
+			reject(row, "edge", "weights must be positive")
+			continue
+		}
+		if err := link.Type.Validate(); err != nil {
+			reject(row, "edge", "%v", err)
+			continue
+		}
+
+		forward, backward := key{from, to, link.Type}, key{to, from, link.Type}
+		if seen[forward] || (link.Mutual && seen[backward]) {
+			reject(row, "edge", "duplicate friendship: %v -> %v", edge.Source, edge.Target)
+			continue
+		}
+		seen[forward] = true
+		if link.Mutual {
+			seen[backward] = true
+		}
+		links = append(links, link)
+	}
+
+	return ResolvedImport{People: people, Links: links, Refs: byID, Errors: errs}
+}
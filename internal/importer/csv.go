@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// ReadCSV decodes nodes (id, name and a column per property) and edges (source, target and
+// the optional weight, type and mutual columns) csv files, both starting with a header.
+//
+// the createdAt column written by exports is ignored, people are created at import time.
+func ReadCSV(nodes, edges io.Reader) (*internal.Import, error) {
+	imp := &internal.Import{}
+
+	header, rows, err := readRecords(nodes, "nodes.csv")
+	if err != nil {
+		return nil, err
+	}
+	cols := columns(header)
+	if _, ok := cols["id"]; !ok {
+		return nil, internal.Errorf(internal.EINVALID, "nodes.csv: missing id column")
+	}
+	if _, ok := cols["name"]; !ok {
+		return nil, internal.Errorf(internal.EINVALID, "nodes.csv: missing name column")
+	}
+	for i, record := range rows {
+		node := internal.ImportNode{
+			ID:   record[cols["id"]],
+			Name: record[cols["name"]],
+			Row:  i + 2, // header is the first row.
+		}
+		for j, key := range header {
+			if key == "id" || key == "name" || key == "createdAt" || record[j] == "" {
+				continue
+			}
+			if node.Properties == nil {
+				node.Properties = make(map[string]string)
+			}
+			node.Properties[key] = record[j]
+		}
+		imp.Nodes = append(imp.Nodes, node)
+	}
+
+	header, rows, err = readRecords(edges, "edges.csv")
+	if err != nil {
+		return nil, err
+	}
+	cols = columns(header)
+	if _, ok := cols["source"]; !ok {
+		return nil, internal.Errorf(internal.EINVALID, "edges.csv: missing source column")
+	}
+	if _, ok := cols["target"]; !ok {
+		return nil, internal.Errorf(internal.EINVALID, "edges.csv: missing target column")
+	}
+	for i, record := range rows {
+		edge := internal.ImportEdge{
+			Source: record[cols["source"]],
+			Target: record[cols["target"]],
+			Row:    i + 2,
+		}
+		if j, ok := cols["weight"]; ok && record[j] != "" {
+			if edge.Weight, err = strconv.ParseFloat(record[j], 64); err != nil {
+				rejectEdge(imp, edge.Row, "invalid weight: %v", record[j])
+				continue
+			}
+		}
+		if j, ok := cols["type"]; ok {
+			edge.Type = internal.RelationType(record[j])
+		}
+		if j, ok := cols["mutual"]; ok {
+			if edge.Mutual, err = parseMutual(record[j]); err != nil {
+				rejectEdge(imp, edge.Row, "invalid mutual: %v", record[j])
+				continue
+			}
+		}
+		imp.Edges = append(imp.Edges, edge)
+	}
+
+	return imp, nil
+}
+
+// readCSVArchive decodes a zip archive holding nodes.csv and edges.csv, as written by csv exports.
+func readCSVArchive(r io.Reader) (*internal.Import, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, internal.WrapError(err, internal.EINVALID, "invalid zip archive")
+	}
+
+	files := make(map[string]*zip.File)
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+	open := func(name string) (io.ReadCloser, error) {
+		file, ok := files[name]
+		if !ok {
+			return nil, internal.Errorf(internal.EINVALID, "archive is missing %v", name)
+		}
+		return file.Open()
+	}
+
+	nodes, err := open("nodes.csv")
+	if err != nil {
+		return nil, err
+	}
+	defer nodes.Close()
+	edges, err := open("edges.csv")
+	if err != nil {
+		return nil, err
+	}
+	defer edges.Close()
+
+	return ReadCSV(nodes, edges)
+}
+
+// readRecords reads the header and rows of a csv file.
+func readRecords(r io.Reader, name string) ([]string, [][]string, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, nil, internal.WrapError(err, internal.EINVALID, name)
+	}
+	if len(records) == 0 {
+		return nil, nil, internal.Errorf(internal.EINVALID, "%v: missing header", name)
+	}
+
+	header := records[0]
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	return header, records[1:], nil
+}
+
+// columns maps the names of the header to their index.
+func columns(header []string) map[string]int {
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[name] = i
+	}
+	return cols
+}
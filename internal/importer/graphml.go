@@ -0,0 +1,131 @@
+package importer
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+type graphML struct {
+	Keys  []graphMLKey `xml:"key"`
+	Graph graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID      string `xml:"id,attr"`
+	For     string `xml:"for,attr"`
+	Name    string `xml:"attr.name,attr"`
+	Default string `xml:"default"`
+}
+
+type graphMLGraph struct {
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed string        `xml:"directed,attr"`
+	Data     []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// readGraphML decodes the first graph of a GraphML document.
+//
+// nodes are named by their name (or label) attribute, falling back to their id, undirected
+// edges are imported as mutual friendships.
+func readGraphML(r io.Reader) (*internal.Import, error) {
+	var doc graphML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, internal.WrapError(err, internal.EINVALID, "invalid graphml")
+	}
+
+	// attributes resolves the data of an element to attribute names, applying key defaults.
+	attributes := func(elem string, data []graphMLData) map[string]string {
+		names := make(map[string]string)
+		attrs := make(map[string]string)
+		for _, key := range doc.Keys {
+			if key.For != elem && key.For != "all" {
+				continue
+			}
+			name := key.Name
+			if name == "" {
+				name = key.ID
+			}
+			names[key.ID] = name
+			if key.Default != "" {
+				attrs[name] = key.Default
+			}
+		}
+		for _, d := range data {
+			if name, ok := names[d.Key]; ok {
+				attrs[name] = d.Value
+			}
+		}
+		return attrs
+	}
+
+	imp := &internal.Import{}
+	for i, n := range doc.Graph.Nodes {
+		attrs := attributes("node", n.Data)
+		node := internal.ImportNode{ID: n.ID, Name: n.ID, Row: i + 1}
+		if label, ok := attrs["label"]; ok {
+			node.Name = label
+		}
+		if name, ok := attrs["name"]; ok {
+			node.Name = name
+		}
+
+		for key, value := range attrs {
+			if key == "name" || key == "label" || key == "createdAt" {
+				continue
+			}
+			if node.Properties == nil {
+				node.Properties = make(map[string]string)
+			}
+			node.Properties[key] = value
+		}
+		imp.Nodes = append(imp.Nodes, node)
+	}
+
+	for i, e := range doc.Graph.Edges {
+		attrs := attributes("edge", e.Data)
+		edge := internal.ImportEdge{
+			Source: e.Source,
+			Target: e.Target,
+			Type:   internal.RelationType(attrs["type"]),
+			Row:    i + 1,
+		}
+		if weight, ok := attrs["weight"]; ok && weight != "" {
+			var err error
+			if edge.Weight, err = strconv.ParseFloat(weight, 64); err != nil {
+				rejectEdge(imp, edge.Row, "invalid weight: %v", weight)
+				continue
+			}
+		}
+
+		mutual, err := parseMutual(attrs["mutual"])
+		if err != nil {
+			rejectEdge(imp, edge.Row, "invalid mutual: %v", attrs["mutual"])
+			continue
+		}
+		directed := e.Directed == "true" || (e.Directed == "" && doc.Graph.EdgeDefault != "undirected")
+		edge.Mutual = mutual || !directed
+		imp.Edges = append(imp.Edges, edge)
+	}
+
+	return imp, nil
+}
@@ -0,0 +1,46 @@
+// Package importer decodes graph files into imports, the counterpart of the export package.
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// Read decodes the import held by r in format.
+func Read(r io.Reader, format internal.ImportFormat) (*internal.Import, error) {
+	switch format {
+	case internal.ImportJSON:
+		return readJSON(r)
+	case internal.ImportGraphML:
+		return readGraphML(r)
+	case internal.ImportCSV:
+		return readCSVArchive(r)
+	}
+	return nil, format.Validate()
+}
+
+func readJSON(r io.Reader) (*internal.Import, error) {
+	var imp internal.Import
+	if err := json.NewDecoder(r).Decode(&imp); err != nil {
+		return nil, internal.WrapError(err, internal.EINVALID, "invalid json import")
+	}
+	return &imp, nil
+}
+
+// rejectEdge reports the edge at row which couldnt be decoded, skipping it as done for the
+// edges referencing unknown nodes.
+func rejectEdge(imp *internal.Import, row int, format string, a ...interface{}) {
+	imp.Errors = append(imp.Errors, internal.ImportError{Row: row, Kind: "edge", Error: fmt.Sprintf(format, a...)})
+}
+
+// parseMutual parses the mutual column or attribute of an edge, empty values are false.
+func parseMutual(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
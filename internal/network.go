@@ -0,0 +1,16 @@
+package internal
+
+// Neighbour represents a person reachable from the center of a network.
+type Neighbour struct {
+	Person   *Person `json:"person"`
+	Distance int     `json:"distance"`
+}
+
+// Network represents the people reachable from Center within a number of hops.
+//
+// Edges holds the induced subgraph over Center and People, only populated when requested.
+type Network struct {
+	Center *Person      `json:"center"`
+	People []Neighbour  `json:"people"`
+	Edges  []Friendship `json:"edges,omitempty"`
+}
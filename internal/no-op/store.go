@@ -20,6 +20,18 @@ func (s NoopStore) AddFriendship(context.Context, internal.Friendship) error {
 	return nil
 }
 
+func (s NoopStore) RemoveFriendship(context.Context, internal.Friendship) error {
+	return nil
+}
+
 func (s NoopStore) RemovePerson(context.Context, int64) error {
 	return nil
 }
+
+func (s NoopStore) AddBulk(context.Context, []*internal.Person, []internal.Link) error {
+	return nil
+}
+
+func (s NoopStore) ApplyBatch(context.Context, []internal.Mutation) error {
+	return nil
+}
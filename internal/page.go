@@ -0,0 +1,25 @@
+package internal
+
+// Page selects up to Limit people ordered by id, starting after the person with id Cursor.
+// The zero Cursor starts from the first person.
+type Page struct {
+	Cursor int64 `json:"cursor"`
+	Limit  int   `json:"limit"`
+}
+
+func (p Page) Validate() error {
+	if p.Cursor < 0 {
+		return Errorf(EINVALID, "cursor must be positive")
+	}
+	if p.Limit < 1 {
+		return Errorf(EINVALID, "limit must be at least 1")
+	}
+	return nil
+}
+
+// FriendshipPage holds a page of friendships, NextCursor is the cursor of the next page and
+// is 0 on the last page.
+type FriendshipPage struct {
+	Friendships []Friendship `json:"friendships"`
+	NextCursor  int64        `json:"nextCursor,omitempty"`
+}
@@ -0,0 +1,10 @@
+package internal
+
+// WeightedPath represents the cheapest path between two people where each hop costs the
+// inverse of the friendship weight, ie: strong friendships are short.
+//
+// Path includes both endpoints and is empty when the people arent connected.
+type WeightedPath struct {
+	Distance float64   `json:"distance"`
+	Path     []*Person `json:"path"`
+}
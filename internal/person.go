@@ -1,18 +1,45 @@
 package internal
 
 import (
+	"strings"
 	"time"
 )
 
 type Person struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID         int64             `json:"id"`
+	Name       string            `json:"name"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	Properties map[string]string `json:"properties,omitempty"` // custom attributes, ie: email, team.
 }
 
 func (p *Person) Validate() error {
 	if p.Name == "" {
 		return Errorf(EINVALID, "name is a required field")
 	}
+
+	for key := range p.Properties {
+		if key == "" || strings.Contains(key, ":") {
+			return Errorf(EINVALID, "property keys must be non empty and cant contain ':'")
+		}
+	}
 	return nil
 }
+
+// Property is a key value pair matched against the properties of people.
+type Property struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ParseProperty parses a property in the key:value form.
+func ParseProperty(s string) (Property, error) {
+	key, value, ok := strings.Cut(s, ":")
+	if !ok || key == "" {
+		return Property{}, Errorf(EINVALID, "invalid property: %v, expected key:value", s)
+	}
+	return Property{Key: key, Value: value}, nil
+}
+
+func (p Property) String() string {
+	return p.Key + ":" + p.Value
+}
@@ -71,6 +71,11 @@ func (db *DB) BeginTX(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 }
 
 func parsePostgreErr(in error) error {
+	var ierr *internal.Error
+	if errors.As(in, &ierr) { // already parsed, ie: validation errors.
+		return in
+	}
+
 	var err *pq.Error
 	if !errors.As(in, &err) {
 		return internal.WrapErrorNil(in, internal.EINTERNAL, "couldnt parse error")
@@ -2,9 +2,11 @@ package postgresql
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/Lambels/relationer/internal"
 	"github.com/Lambels/relationer/internal/service"
+	"github.com/lib/pq"
 )
 
 // PostgreSqlStoreService
@@ -50,6 +52,21 @@ func (s *PostgreSqlStoreService) AddFriendship(ctx context.Context, friendship i
 	return internal.WrapErrorNil(tx.Commit(), internal.EINTERNAL, "tx.Commit")
 }
 
+// RemoveFriendship
+func (s *PostgreSqlStoreService) RemoveFriendship(ctx context.Context, friendship internal.Friendship) error {
+	tx, err := s.db.BeginTX(ctx, nil)
+	if err != nil {
+		return internal.WrapError(err, internal.EINTERNAL, "db.BeginTX")
+	}
+	defer tx.Rollback()
+
+	if err := removeFriendship(ctx, tx, friendship); err != nil {
+		return parsePostgreErr(err)
+	}
+
+	return internal.WrapErrorNil(tx.Commit(), internal.EINTERNAL, "tx.Commit")
+}
+
 // RemovePerson
 func (s *PostgreSqlStoreService) RemovePerson(ctx context.Context, id int64) error {
 	tx, err := s.db.BeginTX(ctx, nil)
@@ -65,6 +82,60 @@ func (s *PostgreSqlStoreService) RemovePerson(ctx context.Context, id int64) err
 	return internal.WrapErrorNil(tx.Commit(), internal.EINTERNAL, "tx.Commit")
 }
 
+// AddBulk adds people and the links between them in a single transaction.
+func (s *PostgreSqlStoreService) AddBulk(ctx context.Context, people []*internal.Person, links []internal.Link) error {
+	tx, err := s.db.BeginTX(ctx, nil)
+	if err != nil {
+		return internal.WrapError(err, internal.EINTERNAL, "db.BeginTX")
+	}
+	defer tx.Rollback()
+
+	// people first, links read the generated ids.
+	for _, person := range people {
+		if err := addPerson(ctx, tx, person); err != nil {
+			return parsePostgreErr(err)
+		}
+	}
+	for _, link := range links {
+		if err := addFriendship(ctx, tx, link.Friendship()); err != nil {
+			return parsePostgreErr(err)
+		}
+	}
+
+	return internal.WrapErrorNil(tx.Commit(), internal.EINTERNAL, "tx.Commit")
+}
+
+// ApplyBatch applies muts in order in a single transaction, recording the time they were
+// stored at.
+func (s *PostgreSqlStoreService) ApplyBatch(ctx context.Context, muts []internal.Mutation) error {
+	tx, err := s.db.BeginTX(ctx, nil)
+	if err != nil {
+		return internal.WrapError(err, internal.EINTERNAL, "db.BeginTX")
+	}
+	defer tx.Rollback()
+
+	for i, mut := range muts {
+		muts[i].At = tx.now
+		switch mut.Op {
+		case internal.AddPersonOp:
+			err = addPerson(ctx, tx, mut.Person)
+		case internal.AddFriendshipOp:
+			err = addFriendship(ctx, tx, mut.Link.Friendship())
+		case internal.RemoveFriendshipOp:
+			err = removeFriendship(ctx, tx, mut.Link.Friendship())
+		case internal.RemovePersonOp:
+			err = removePerson(ctx, tx, mut.Person.ID)
+		default:
+			err = internal.Errorf(internal.EINVALID, "unknown operation: %v", mut.Op)
+		}
+		if err != nil {
+			return parsePostgreErr(err)
+		}
+	}
+
+	return internal.WrapErrorNil(tx.Commit(), internal.EINTERNAL, "tx.Commit")
+}
+
 func addPerson(ctx context.Context, tx *Tx, person *internal.Person) error {
 	person.CreatedAt = tx.now
 
@@ -72,15 +143,25 @@ func addPerson(ctx context.Context, tx *Tx, person *internal.Person) error {
 		return err
 	}
 
+	properties := []byte("{}")
+	if len(person.Properties) != 0 {
+		var err error
+		if properties, err = json.Marshal(person.Properties); err != nil {
+			return err
+		}
+	}
+
 	var id int64
 	if err := tx.QueryRowContext(ctx, `
 	INSERT INTO people (
 		name,
-		created_at
-	) VALUES ($1, $2)
+		created_at,
+		properties
+	) VALUES ($1, $2, $3)
 	RETURNING id`,
 		person.Name,
 		person.CreatedAt,
+		properties,
 	).Scan(&id); err != nil {
 		return err
 	}
@@ -94,20 +175,121 @@ func addFriendship(ctx context.Context, tx *Tx, friendship internal.Friendship)
 		return err
 	}
 
+	// removed people keep their rows, the foreign keys dont reject friendships with them.
+	if err := lockPeople(ctx, tx, friendship.P1.ID, friendship.With[0]); err != nil {
+		return err
+	}
+
+	if !friendship.Mutual {
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO friendships (
+			person1_id,
+			person2_id,
+			weight,
+			type,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5)
+		`,
+			friendship.P1.ID,
+			friendship.With[0],
+			friendship.Weight(0),
+			friendship.Type(0),
+			tx.now,
+		)
+		return err
+	}
+
+	// mutual friendships write both rows, either may already exist and takes the new weight
+	// as the graph does.
 	_, err := tx.ExecContext(ctx, `
 	INSERT INTO friendships (
 		person1_id,
-		person2_id
-	) VALUES ($1, $2)
+		person2_id,
+		weight,
+		type,
+		created_at
+	) VALUES ($1, $2, $3, $4, $5), ($2, $1, $3, $4, $5)
+	ON CONFLICT (person1_id, person2_id, type) WHERE ended_at IS NULL
+	DO UPDATE SET weight = EXCLUDED.weight
 	`,
 		friendship.P1.ID,
 		friendship.With[0],
+		friendship.Weight(0),
+		friendship.Type(0),
+		tx.now,
 	)
-
 	return err
 }
 
+// lockPeople locks the people with ids until tx ends, so they cant be removed meanwhile.
+//
+// returns ENOTFOUND if one of them isnt found or was removed.
+func lockPeople(ctx context.Context, tx *Tx, ids ...int64) error {
+	distinct := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		distinct[id] = true
+	}
+
+	var n int
+	if err := tx.QueryRowContext(ctx, `
+	SELECT count(*) FROM (
+		SELECT id FROM people WHERE id = ANY($1) AND ended_at IS NULL FOR SHARE
+	) AS open
+	`,
+		pq.Array(ids),
+	).Scan(&n); err != nil {
+		return err
+	}
+
+	if n != len(distinct) {
+		return internal.Errorf(internal.ENOTFOUND, "person not found")
+	}
+	return nil
+}
+
+func removeFriendship(ctx context.Context, tx *Tx, friendship internal.Friendship) error {
+	if err := friendship.Validate(); err != nil {
+		return err
+	}
+
+	// friendships are ended instead of deleted, keeping the history.
+	res, err := tx.ExecContext(ctx, `
+	UPDATE friendships SET ended_at = $5
+	WHERE type = $4 AND ended_at IS NULL AND (
+		(person1_id = $1 AND person2_id = $2)
+		OR ($3 AND person1_id = $2 AND person2_id = $1)
+	)
+	`,
+		friendship.P1.ID,
+		friendship.With[0],
+		friendship.Mutual,
+		friendship.Type(0),
+		tx.now,
+	)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return internal.Errorf(internal.ENOTFOUND, "friendship not found")
+	}
+	return nil
+}
+
+// removePerson ends the person alongside their friendships, keeping the history.
 func removePerson(ctx context.Context, tx *Tx, id int64) error {
-	_, err := tx.ExecContext(ctx, `DELETE FROM people WHERE id = ?`, id)
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE friendships SET ended_at = $2
+	WHERE (person1_id = $1 OR person2_id = $1) AND ended_at IS NULL
+	`,
+		id,
+		tx.now,
+	); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `UPDATE people SET ended_at = $2 WHERE id = $1 AND ended_at IS NULL`, id, tx.now)
 	return err
 }
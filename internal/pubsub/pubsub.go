@@ -0,0 +1,115 @@
+// Package pubsub fans out the events published to the message broker to subscribers of the
+// same process, used by the apis streaming changes to their clients.
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/service"
+)
+
+// bufferSize is the number of events a subscriber can fall behind by before being dropped.
+const bufferSize = 64
+
+// Broker is a service.MessageBroker publishing to next and, once next succeeds, to every
+// subscriber.
+type Broker struct {
+	next service.MessageBroker
+
+	mu   sync.Mutex
+	subs map[chan internal.Event]struct{}
+}
+
+// New wraps next, next can be nil to only publish to subscribers.
+func New(next service.MessageBroker) *Broker {
+	return &Broker{
+		next: next,
+		subs: make(map[chan internal.Event]struct{}),
+	}
+}
+
+func (b *Broker) CreatedPerson(ctx context.Context, person *internal.Person) error {
+	if b.next != nil {
+		if err := b.next.CreatedPerson(ctx, person); err != nil {
+			return err
+		}
+	}
+	b.publish(internal.Event{Key: internal.PersonCreated, Person: person})
+	return nil
+}
+
+func (b *Broker) CreatedFriendship(ctx context.Context, friendship internal.Friendship) error {
+	if b.next != nil {
+		if err := b.next.CreatedFriendship(ctx, friendship); err != nil {
+			return err
+		}
+	}
+	b.publish(internal.Event{Key: internal.FriendshipCreated, Friendship: &friendship})
+	return nil
+}
+
+func (b *Broker) DeletedFriendship(ctx context.Context, friendship internal.Friendship) error {
+	if b.next != nil {
+		if err := b.next.DeletedFriendship(ctx, friendship); err != nil {
+			return err
+		}
+	}
+	b.publish(internal.Event{Key: internal.FriendshipDeleted, Friendship: &friendship})
+	return nil
+}
+
+func (b *Broker) DeletedPerson(ctx context.Context, id int64) error {
+	if b.next != nil {
+		if err := b.next.DeletedPerson(ctx, id); err != nil {
+			return err
+		}
+	}
+	b.publish(internal.Event{Key: internal.PersonDeleted, ID: id})
+	return nil
+}
+
+// Subscribe returns the events published from now on, the channel is closed once ctx is
+// done or when the subscriber falls more than bufferSize events behind.
+func (b *Broker) Subscribe(ctx context.Context) <-chan internal.Event {
+	ch := make(chan internal.Event, bufferSize)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(ch)
+	}()
+	return ch
+}
+
+func (b *Broker) unsubscribe(ch chan internal.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
+
+func (b *Broker) publish(event internal.Event) {
+	event.Time = time.Now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- event:
+		default:
+			// slow subscribers are dropped rather than blocking the publisher.
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+}
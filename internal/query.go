@@ -0,0 +1,41 @@
+package internal
+
+import "fmt"
+
+// QueryResult holds the rows matched by a query, each row has a value per column.
+type QueryResult struct {
+	Columns []string       `json:"columns"`
+	Rows    [][]QueryValue `json:"rows"`
+}
+
+// QueryValue is a single value returned by a query, either a person, a relationship or a
+// scalar (string, number or bool) when returning a field.
+type QueryValue struct {
+	Person       *Person       `json:"person,omitempty"`
+	Relationship *Relationship `json:"relationship,omitempty"`
+	Value        interface{}   `json:"value,omitempty"` // nil when the field is missing.
+}
+
+func (v QueryValue) String() string {
+	switch {
+	case v.Person != nil:
+		return fmt.Sprintf("%v (%v)", v.Person.Name, v.Person.ID)
+	case v.Relationship != nil:
+		return v.Relationship.String()
+	case v.Value == nil:
+		return "null"
+	}
+	return fmt.Sprint(v.Value)
+}
+
+// Relationship is a single relationship of a kind from P1 to P2.
+type Relationship struct {
+	P1     int64        `json:"p1"`
+	P2     int64        `json:"p2"`
+	Type   RelationType `json:"type"`
+	Weight float64      `json:"weight"`
+}
+
+func (r *Relationship) String() string {
+	return fmt.Sprintf("%v -[%v %v]-> %v", r.P1, r.Type, r.Weight, r.P2)
+}
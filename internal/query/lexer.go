@@ -0,0 +1,122 @@
+package query
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+type tokenKind uint8
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokPunct // single or double character punctuation, ie: ( -> <= ..
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	pos    int // byte offset of the token in the query.
+	quoted bool
+}
+
+// is reports whether the token is the punctuation or keyword (case insensitive) s.
+func (t token) is(s string) bool {
+	switch t.kind {
+	case tokPunct:
+		return t.text == s
+	case tokIdent:
+		return !t.quoted && strings.EqualFold(t.text, s)
+	}
+	return false
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of query"
+	case tokString:
+		return "string " + quote(t.text)
+	}
+	return quote(t.text)
+}
+
+func quote(s string) string {
+	return "\"" + s + "\""
+}
+
+// punctuation holds the double character punctuation, matched before single characters.
+var punctuation = []string{"<>", "<=", ">=", ".."}
+
+// lex splits q into tokens, the last one being tokEOF.
+func lex(q string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(q); {
+		c := q[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '"' || c == '\'' || c == '`':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(q) && q[j] != c; j++ {
+				if q[j] == '\\' && j+1 < len(q) {
+					j++
+				}
+				b.WriteByte(q[j])
+			}
+			if j == len(q) {
+				return nil, internal.Errorf(internal.EINVALID, "unterminated quote at %v", i)
+			}
+			if c == '`' {
+				tokens = append(tokens, token{kind: tokIdent, text: b.String(), pos: i, quoted: true})
+			} else {
+				tokens = append(tokens, token{kind: tokString, text: b.String(), pos: i})
+			}
+			i = j + 1
+
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(q) && q[j] >= '0' && q[j] <= '9' {
+				j++
+			}
+			// a dot followed by a digit is a fraction, otherwise a range (1..3).
+			if j+1 < len(q) && q[j] == '.' && q[j+1] >= '0' && q[j+1] <= '9' {
+				j++
+				for j < len(q) && q[j] >= '0' && q[j] <= '9' {
+					j++
+				}
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: q[i:j], pos: i})
+			i = j
+
+		case c == '_' || unicode.IsLetter(rune(c)) || c >= 0x80:
+			j := i
+			for j < len(q) && (q[j] == '_' || q[j] >= 0x80 || unicode.IsLetter(rune(q[j])) || unicode.IsDigit(rune(q[j]))) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: q[i:j], pos: i})
+			i = j
+
+		default:
+			text := q[i : i+1]
+			for _, p := range punctuation {
+				if strings.HasPrefix(q[i:], p) {
+					text = p
+					break
+				}
+			}
+			if !strings.Contains("()[]{}:,.-<>=*|", text) && len(text) == 1 {
+				return nil, internal.Errorf(internal.EINVALID, "unexpected character %q at %v", c, i)
+			}
+			tokens = append(tokens, token{kind: tokPunct, text: text, pos: i})
+			i += len(text)
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(q)}), nil
+}
@@ -0,0 +1,488 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Lambels/relationer/internal"
+)
+
+// Parse parses and validates q.
+//
+// returns EINVALID if q isnt a valid query.
+func Parse(q string) (*Query, error) {
+	tokens, err := lex(q)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, vars: make(map[string]varKind)}
+	return p.parse()
+}
+
+type varKind uint8
+
+const (
+	nodeVar varKind = iota + 1
+	relVar
+)
+
+type parser struct {
+	tokens []token
+	pos    int
+	vars   map[string]varKind // variables bound by the pattern.
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is s.
+func (p *parser) accept(s string) bool {
+	if p.peek().is(s) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(s string) error {
+	if !p.accept(s) {
+		return p.unexpected(quote(s))
+	}
+	return nil
+}
+
+func (p *parser) unexpected(want string) error {
+	t := p.peek()
+	return internal.Errorf(internal.EINVALID, "unexpected %v at %v, expected %v", t, t.pos, want)
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return "", p.unexpected("identifier")
+	}
+	p.pos++
+	return t.text, nil
+}
+
+func (p *parser) parse() (*Query, error) {
+	if err := p.expect("MATCH"); err != nil {
+		return nil, err
+	}
+	pattern, err := p.pattern()
+	if err != nil {
+		return nil, err
+	}
+	query := &Query{Pattern: pattern, Limit: DefaultLimit}
+
+	if p.accept("WHERE") {
+		if query.Where, err = p.or(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.expect("RETURN"); err != nil {
+		return nil, err
+	}
+	for {
+		item, err := p.returnItem()
+		if err != nil {
+			return nil, err
+		}
+		query.Return = append(query.Return, item)
+		if !p.accept(",") {
+			break
+		}
+	}
+
+	if p.accept("LIMIT") {
+		t := p.next()
+		limit, err := strconv.Atoi(t.text)
+		if t.kind != tokNumber || err != nil {
+			return nil, internal.Errorf(internal.EINVALID, "LIMIT requires an integer at %v", t.pos)
+		}
+		if limit < 1 || limit > MaxLimit {
+			return nil, internal.Errorf(internal.EINVALID, "LIMIT must be between 1 and %v", MaxLimit)
+		}
+		query.Limit = limit
+	}
+
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.unexpected("end of query")
+	}
+	return query, nil
+}
+
+// bind records name as a variable of kind, node variables can repeat to match cycles.
+func (p *parser) bind(name string, kind varKind, pos int) error {
+	if name == "" {
+		return nil
+	}
+	if bound, ok := p.vars[name]; ok && (bound != kind || kind == relVar) {
+		return internal.Errorf(internal.EINVALID, "variable %v already bound at %v", name, pos)
+	}
+	p.vars[name] = kind
+	return nil
+}
+
+func (p *parser) pattern() (Pattern, error) {
+	var pattern Pattern
+	node, err := p.node()
+	if err != nil {
+		return pattern, err
+	}
+	pattern.Nodes = append(pattern.Nodes, node)
+
+	for p.peek().is("-") || p.peek().is("<") {
+		rel, err := p.rel()
+		if err != nil {
+			return pattern, err
+		}
+		node, err := p.node()
+		if err != nil {
+			return pattern, err
+		}
+		pattern.Rels = append(pattern.Rels, rel)
+		pattern.Nodes = append(pattern.Nodes, node)
+	}
+	return pattern, nil
+}
+
+// node parses (var:Person {key: value, ...}).
+func (p *parser) node() (NodePattern, error) {
+	var node NodePattern
+	if err := p.expect("("); err != nil {
+		return node, err
+	}
+
+	if t := p.peek(); t.kind == tokIdent {
+		p.pos++
+		if err := p.bind(t.text, nodeVar, t.pos); err != nil {
+			return node, err
+		}
+		node.Var = t.text
+	}
+	if p.accept(":") {
+		t := p.peek()
+		label, err := p.ident()
+		if err != nil {
+			return node, err
+		}
+		if label != "Person" {
+			return node, internal.Errorf(internal.EINVALID, "unknown label %v at %v", label, t.pos)
+		}
+	}
+
+	if p.accept("{") {
+		node.Props = make(map[string]Literal)
+		for !p.accept("}") {
+			if len(node.Props) != 0 {
+				if err := p.expect(","); err != nil {
+					return node, err
+				}
+			}
+			key, err := p.ident()
+			if err != nil {
+				return node, err
+			}
+			if err := p.expect(":"); err != nil {
+				return node, err
+			}
+			lit, err := p.literal()
+			if err != nil {
+				return node, err
+			}
+			node.Props[key] = lit
+		}
+	}
+
+	return node, p.expect(")")
+}
+
+// rel parses -[var:type|type *min..max]-> and its incoming and undirected forms.
+func (p *parser) rel() (RelPattern, error) {
+	rel := RelPattern{Direction: Both, Min: 1, Max: 1}
+	start := p.peek().pos
+	incoming := p.accept("<")
+	if err := p.expect("-"); err != nil {
+		return rel, err
+	}
+
+	if p.accept("[") {
+		if t := p.peek(); t.kind == tokIdent {
+			p.pos++
+			if err := p.bind(t.text, relVar, t.pos); err != nil {
+				return rel, err
+			}
+			rel.Var = t.text
+		}
+		if p.accept(":") {
+			for {
+				t := p.peek()
+				kind, err := p.ident()
+				if err != nil {
+					return rel, err
+				}
+				if internal.RelationType(kind).Validate() != nil {
+					return rel, internal.Errorf(internal.EINVALID, "invalid relationship type %v at %v", kind, t.pos)
+				}
+				rel.Types = append(rel.Types, kind)
+				if !p.accept("|") {
+					break
+				}
+			}
+		}
+		if p.accept("*") {
+			if err := p.hops(&rel); err != nil {
+				return rel, err
+			}
+		}
+		if err := p.expect("]"); err != nil {
+			return rel, err
+		}
+	}
+
+	if err := p.expect("-"); err != nil {
+		return rel, err
+	}
+	outgoing := p.accept(">")
+	switch {
+	case incoming && outgoing:
+		return rel, internal.Errorf(internal.EINVALID, "relationship at %v has two directions", start)
+	case incoming:
+		rel.Direction = Incoming
+	case outgoing:
+		rel.Direction = Outgoing
+	}
+
+	if rel.VarLength && rel.Var != "" {
+		return rel, internal.Errorf(internal.EINVALID, "variable length relationship at %v cant be bound to a variable", start)
+	}
+	return rel, nil
+}
+
+// hops parses the bounds of a variable length relationship: *n, *min..max or *..max.
+func (p *parser) hops(rel *RelPattern) error {
+	rel.VarLength = true
+	pos := p.peek().pos
+
+	number := func() (int, bool) {
+		t := p.peek()
+		if t.kind != tokNumber {
+			return 0, false
+		}
+		n, err := strconv.Atoi(t.text)
+		if err != nil {
+			return 0, false
+		}
+		p.pos++
+		return n, true
+	}
+
+	min, hasMin := number()
+	switch {
+	case p.accept(".."):
+		max, ok := number()
+		if !ok {
+			return internal.Errorf(internal.EINVALID, "variable length relationship at %v must be bounded, ie: *1..3", pos)
+		}
+		rel.Max = max
+		if hasMin {
+			rel.Min = min
+		}
+	case hasMin:
+		rel.Min, rel.Max = min, min
+	default:
+		return internal.Errorf(internal.EINVALID, "variable length relationship at %v must be bounded, ie: *1..3", pos)
+	}
+
+	if rel.Min > rel.Max {
+		return internal.Errorf(internal.EINVALID, "variable length relationship at %v has a lower bound above its upper bound", pos)
+	}
+	if rel.Max > MaxHops {
+		return internal.Errorf(internal.EINVALID, "variable length relationship at %v exceeds %v hops", pos, MaxHops)
+	}
+	return nil
+}
+
+func (p *parser) returnItem() (ReturnItem, error) {
+	t := p.peek()
+	operand, err := p.variable()
+	if err != nil {
+		return ReturnItem{}, err
+	}
+	if p.vars[operand.Var] == relVar && operand.Field != "" && !isRelField(operand.Field) {
+		return ReturnItem{}, internal.Errorf(internal.EINVALID, "unknown relationship field %v at %v", operand.Field, t.pos)
+	}
+	return ReturnItem{Var: operand.Var, Field: operand.Field}, nil
+}
+
+// variable parses var or var.field, var must be bound by the pattern.
+func (p *parser) variable() (Operand, error) {
+	t := p.peek()
+	name, err := p.ident()
+	if err != nil {
+		return Operand{}, err
+	}
+	if _, ok := p.vars[name]; !ok {
+		return Operand{}, internal.Errorf(internal.EINVALID, "unknown variable %v at %v", name, t.pos)
+	}
+
+	operand := Operand{Var: name}
+	if p.accept(".") {
+		if operand.Field, err = p.ident(); err != nil {
+			return Operand{}, err
+		}
+	}
+	return operand, nil
+}
+
+func isRelField(field string) bool {
+	return field == "type" || field == "weight"
+}
+
+func (p *parser) or() (Expr, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("OR") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: "OR", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (Expr, error) {
+	left, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("AND") {
+		right, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: "AND", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) not() (Expr, error) {
+	if p.accept("NOT") {
+		expr, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	}
+	if p.accept("(") {
+		expr, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return expr, p.expect(")")
+	}
+	return p.comparison()
+}
+
+var comparisons = []string{"=", "<>", "<", "<=", ">", ">=", "CONTAINS"}
+
+func (p *parser) comparison() (Expr, error) {
+	left, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	var op string
+	for _, c := range comparisons {
+		if p.accept(c) {
+			op = c
+			break
+		}
+	}
+	if op == "" {
+		for _, c := range []string{"STARTS", "ENDS"} {
+			if p.accept(c) {
+				if err := p.expect("WITH"); err != nil {
+					return nil, err
+				}
+				op = c + " WITH"
+				break
+			}
+		}
+	}
+	if op == "" {
+		return nil, p.unexpected("comparison operator")
+	}
+
+	right, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	return &Comparison{Op: op, Left: left, Right: right}, nil
+}
+
+func (p *parser) operand() (Operand, error) {
+	if t := p.peek(); t.kind == tokIdent && !t.is("TRUE") && !t.is("FALSE") {
+		operand, err := p.variable()
+		if err != nil {
+			return operand, err
+		}
+		if p.vars[operand.Var] == relVar && operand.Field != "" && !isRelField(operand.Field) {
+			return operand, internal.Errorf(internal.EINVALID, "unknown relationship field %v at %v", operand.Field, t.pos)
+		}
+		return operand, nil
+	}
+
+	lit, err := p.literal()
+	if err != nil {
+		return Operand{}, err
+	}
+	return Operand{Literal: &lit}, nil
+}
+
+// literal parses a string, number (optionally negative) or boolean.
+func (p *parser) literal() (Literal, error) {
+	t := p.peek()
+	negative := t.is("-")
+	if negative {
+		p.pos++
+		t = p.peek()
+	}
+
+	switch {
+	case t.kind == tokNumber:
+		p.pos++
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return Literal{}, internal.Errorf(internal.EINVALID, "invalid number %v at %v", t.text, t.pos)
+		}
+		if negative {
+			n = -n
+		}
+		return Literal{Value: n}, nil
+	case negative:
+		return Literal{}, p.unexpected("number")
+	case t.kind == tokString:
+		p.pos++
+		return Literal{Value: t.text}, nil
+	case t.is("TRUE"), t.is("FALSE"):
+		p.pos++
+		return Literal{Value: strings.EqualFold(t.text, "true")}, nil
+	}
+	return Literal{}, p.unexpected("literal")
+}
@@ -0,0 +1,130 @@
+// Package query parses the query language of relationer, a small subset of Cypher matching a
+// single path pattern over people and their friendships:
+//
+//	MATCH (a {name: "Alice"})-[:friend*1..3]->(b:Person)
+//	WHERE b.team = "infra" AND NOT b.name STARTS WITH "B"
+//	RETURN a, b.name
+//	LIMIT 10
+//
+// nodes are people, written as (var:Person {key: value, ...}) where every part is optional,
+// the id and name keys match the id and name of the person and any other key a property.
+//
+// relationships are written as -[var:type|type *min..max]-> (outgoing), <-[...]- (incoming)
+// or -[...]- (either direction), -->, <-- and -- match any relationship. Variable length
+// relationships must be bounded and cant be bound to a variable, they match each person whose
+// hop distance lies within the bounds once.
+//
+// WHERE combines comparisons (=, <>, <, <=, >, >=, CONTAINS, STARTS WITH, ENDS WITH) with
+// AND, OR, NOT and parentheses. The fields of a person are id, name and its properties, the
+// fields of a relationship are type and weight. Keywords are case insensitive.
+package query
+
+// Limits of the language.
+const (
+	// MaxHops is the largest upper bound of a variable length relationship.
+	MaxHops = 6
+	// DefaultLimit is the number of rows returned by queries without a LIMIT clause.
+	DefaultLimit = 100
+	// MaxLimit is the largest LIMIT accepted.
+	MaxLimit = 1000
+)
+
+// Query is a parsed query.
+type Query struct {
+	Pattern Pattern
+	Where   Expr // nil without a WHERE clause.
+	Return  []ReturnItem
+	Limit   int
+}
+
+// Columns returns the names of the returned columns, in order.
+func (q *Query) Columns() []string {
+	cols := make([]string, len(q.Return))
+	for i, item := range q.Return {
+		cols[i] = item.String()
+	}
+	return cols
+}
+
+// Pattern is a path of nodes joined by relationships, Rels[i] joins Nodes[i] and Nodes[i+1].
+type Pattern struct {
+	Nodes []NodePattern
+	Rels  []RelPattern
+}
+
+// NodePattern matches people with every property in Props.
+type NodePattern struct {
+	Var   string // empty for anonymous nodes.
+	Props map[string]Literal
+}
+
+// Direction is the direction a relationship is followed in.
+type Direction uint8
+
+const (
+	Outgoing Direction = iota + 1
+	Incoming
+	Both
+)
+
+// RelPattern matches relationships of any kind in Types (any kind when empty), between Min and
+// Max hops long.
+type RelPattern struct {
+	Var       string
+	Types     []string
+	Direction Direction
+	Min, Max  int
+	VarLength bool
+}
+
+// ReturnItem is a returned variable or field of a variable.
+type ReturnItem struct {
+	Var   string
+	Field string // empty when returning the variable.
+}
+
+func (r ReturnItem) String() string {
+	if r.Field == "" {
+		return r.Var
+	}
+	return r.Var + "." + r.Field
+}
+
+// Expr is a boolean expression of a WHERE clause, one of *Binary, *Not or *Comparison.
+type Expr interface {
+	expr()
+}
+
+// Binary is the conjunction (AND) or disjunction (OR) of two expressions.
+type Binary struct {
+	Op          string
+	Left, Right Expr
+}
+
+// Not negates an expression.
+type Not struct {
+	Expr Expr
+}
+
+// Comparison compares two operands with Op, one of =, <>, <, <=, >, >=, CONTAINS,
+// STARTS WITH or ENDS WITH.
+type Comparison struct {
+	Op          string
+	Left, Right Operand
+}
+
+func (*Binary) expr()     {}
+func (*Not) expr()        {}
+func (*Comparison) expr() {}
+
+// Operand is either a literal or a variable, optionally with a field.
+type Operand struct {
+	Var     string
+	Field   string
+	Literal *Literal
+}
+
+// Literal is a string, float64 or bool constant.
+type Literal struct {
+	Value interface{}
+}
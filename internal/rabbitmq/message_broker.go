@@ -15,6 +15,7 @@ const (
 	MesssagePersonCreated    = "person.created"
 	MessagePersonDeleted     = "person.deleted"
 	MessageFriendshipCreated = "friendship.created"
+	MessageFriendshipDeleted = "friendship.deleted"
 )
 
 type RabbitMq struct {
@@ -35,6 +36,10 @@ func (s *RabbitMq) CreatedFriendship(ctx context.Context, friendship internal.Fr
 	return s.pushMsg(ctx, "friendship.created", friendship, MessageFriendshipCreated)
 }
 
+func (s *RabbitMq) DeletedFriendship(ctx context.Context, friendship internal.Friendship) error {
+	return s.pushMsg(ctx, "friendship.deleted", friendship, MessageFriendshipDeleted)
+}
+
 func (s *RabbitMq) DeletedPerson(ctx context.Context, id int64) error {
 	return s.pushMsg(ctx, "person.deleted", map[string]int64{"id": id}, MessagePersonDeleted)
 }
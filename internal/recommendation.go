@@ -0,0 +1,28 @@
+package internal
+
+// Algorithm represents the scoring algorithm used to rank recommendations.
+type Algorithm string
+
+// Scoring algorithms for friends-of-friends recommendations.
+const (
+	// CommonNeighbours scores by the number of shared neighbours.
+	CommonNeighbours Algorithm = "common"
+	// Jaccard scores by the shared neighbours over the union of neighbours.
+	Jaccard Algorithm = "jaccard"
+	// AdamicAdar scores by the shared neighbours weighted by 1 / log(degree).
+	AdamicAdar Algorithm = "adamic-adar"
+)
+
+func (a Algorithm) Validate() error {
+	switch a {
+	case CommonNeighbours, Jaccard, AdamicAdar:
+		return nil
+	}
+	return Errorf(EINVALID, "unknown algorithm: %v", a)
+}
+
+// Recommendation represents a person who isnt a friend yet alongside their score.
+type Recommendation struct {
+	Person *Person `json:"person"`
+	Score  float64 `json:"score"`
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// RelationType is the kind of relationship a friendship represents, friendships of different
+// types between the same people are independent of each other.
+type RelationType string
+
+const (
+	Friend    RelationType = "friend"
+	Colleague RelationType = "colleague"
+	Family    RelationType = "family"
+)
+
+// DefaultRelation is the type of friendships created without one.
+const DefaultRelation = Friend
+
+// Validate checks that the type is made of lowercase letters, digits, '-' or '_'.
+func (t RelationType) Validate() error {
+	if t == "" || len(t) > 32 {
+		return Errorf(EINVALID, "relationship type must have between 1 and 32 characters")
+	}
+
+	for _, r := range t {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return Errorf(EINVALID, "invalid relationship type: %v", t)
+		}
+	}
+	return nil
+}
+
+// EdgeFilter restricts the friendships followed by traversals, the zero value allows every
+// friendship.
+//
+// when AsOf is set traversals run over the graph as it was at that time, including people
+// and friendships removed since.
+type EdgeFilter struct {
+	Types []RelationType `json:"types,omitempty"`
+	AsOf  time.Time      `json:"asOf,omitempty"`
+}
+
+func (f EdgeFilter) Validate() error {
+	for _, t := range f.Types {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Allows reports whether friendships of type t pass the filter.
+func (f EdgeFilter) Allows(t RelationType) bool {
+	if len(f.Types) == 0 {
+		return true
+	}
+
+	for _, allowed := range f.Types {
+		if allowed == t {
+			return true
+		}
+	}
+	return false
+}
+
+// TypesString returns the comma separated sorted types of the filter.
+func (f EdgeFilter) TypesString() string {
+	types := make([]string, len(f.Types))
+	for i, t := range f.Types {
+		types[i] = string(t)
+	}
+	sort.Strings(types)
+	return strings.Join(types, ",")
+}
+
+// String returns the filter in a canonical form, suitable for cache keys.
+func (f EdgeFilter) String() string {
+	if f.AsOf.IsZero() {
+		return f.TypesString()
+	}
+	return f.TypesString() + "@" + f.AsOf.UTC().Format(time.RFC3339Nano)
+}
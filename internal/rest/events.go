@@ -0,0 +1,140 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// eventStreamContentType is the content type of server-sent events.
+	eventStreamContentType = "text/event-stream"
+	// keepAlive is the interval of the comments or pings sent on idle event streams.
+	keepAlive = 15 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// events hold no secrets of the client, any origin can stream them.
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// streamEvents streams the events published from now on as server-sent events, or over a
+// websocket when upgrading, without a connection to the message broker.
+//
+// the comma separated keys query param filters the events by their routing key with the
+// binding keys of the message broker (ie: person.* or #), every event is sent when missing.
+func (h *HandlerService) streamEvents(w http.ResponseWriter, r *http.Request) {
+	var keys []string
+	for _, key := range strings.Split(r.URL.Query().Get("keys"), ",") {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	if websocket.IsWebSocketUpgrade(r) {
+		h.eventsWebsocket(w, r, keys)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		sendErrorResponse(w, internal.Errorf(internal.EINTERNAL, "streaming unsupported"))
+		return
+	}
+
+	// event streams stay open until the client leaves, past the write timeout of the server.
+	http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	events := h.events.Subscribe(r.Context())
+	w.Header().Set("Content-Type", eventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush() // the headers tell the client the subscription is in place.
+
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return // client gone or fell too far behind.
+			}
+			if !internal.MatchAny(keys, event.Key) {
+				continue
+			}
+
+			data, err := json.Marshal(event.Payload())
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: %v\ndata: %s\n\n", event.Key, data); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// eventsWebsocket sends each event as an EventMessage, messages from the client are
+// discarded.
+func (h *HandlerService) eventsWebsocket(w http.ResponseWriter, r *http.Request, keys []string) {
+	// subscribing before the handshake doesnt miss events published right after it.
+	events := h.events.Subscribe(r.Context())
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // the upgrader replied with the error.
+	}
+	defer conn.Close()
+
+	// reading detects the client closing the connection.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "fell too far behind the events"), time.Now().Add(time.Second))
+				return
+			}
+			if !internal.MatchAny(keys, event.Key) {
+				continue
+			}
+
+			data, err := json.Marshal(event.Payload())
+			if err != nil {
+				return
+			}
+			if err := conn.WriteJSON(EventMessage{Type: event.Key, Data: data}); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second)); err != nil {
+				return
+			}
+
+		case <-closed:
+			return
+		}
+	}
+}
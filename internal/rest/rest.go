@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/Lambels/relationer/internal"
 )
@@ -16,6 +17,92 @@ type GetDepthResponse struct {
 	Depth int `json:"depth"`
 }
 
+// FindPeopleResponse holds the people matching every where property.
+type FindPeopleResponse struct {
+	Count  int                `json:"count"`
+	People []*internal.Person `json:"people"`
+}
+
+// SearchPeopleResponse holds the people matching a name search, best first.
+type SearchPeopleResponse struct {
+	Results []internal.SearchResult `json:"results"`
+}
+
+// GetPathResponse holds the ordered hops from the first person to the second,
+// endpoints included. Path is empty when the people arent connected.
+type GetPathResponse struct {
+	Connected bool               `json:"connected"`
+	Path      []*internal.Person `json:"path"`
+}
+
+// GetWeightedPathResponse holds the cheapest path from the first person to the second,
+// endpoints included. Path is empty when the people arent connected.
+type GetWeightedPathResponse struct {
+	Connected bool               `json:"connected"`
+	Distance  float64            `json:"distance"`
+	Path      []*internal.Person `json:"path"`
+}
+
+// GetPathsResponse holds the simple paths found between two people.
+type GetPathsResponse struct {
+	Paths [][]*internal.Person `json:"paths"`
+}
+
+// GetMutualResponse holds the friends shared by two people.
+type GetMutualResponse struct {
+	Count  int                `json:"count"`
+	People []*internal.Person `json:"people"`
+}
+
+// GetRecommendationsResponse holds the ranked recommendations for a person.
+type GetRecommendationsResponse struct {
+	Algorithm       internal.Algorithm        `json:"algorithm"`
+	Recommendations []internal.Recommendation `json:"recommendations"`
+}
+
+// GetClustersResponse holds the clusters of a kind, from the biggest to the smallest.
+type GetClustersResponse struct {
+	Kind     internal.ClusterKind `json:"kind"`
+	Count    int                  `json:"count"`
+	Clusters []internal.Cluster   `json:"clusters"`
+}
+
+// GetTopCentralityResponse holds the leaderboard of a centrality metric.
+type GetTopCentralityResponse struct {
+	Metric internal.Metric   `json:"metric"`
+	People []internal.Ranked `json:"people"`
+}
+
+// QueryRequest holds a query written in the query language.
+type QueryRequest struct {
+	Query string `json:"query"`
+}
+
+// EventMessage is an event sent over the websocket of GET /events, Data is the body of the
+// event as published to the message broker.
+type EventMessage struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+const (
+	// ndjsonContentType is the content type of newline delimited json streams.
+	ndjsonContentType = "application/x-ndjson"
+
+	// defaultPageSize is the number of people in a page when no limit is provided.
+	defaultPageSize = 100
+	// streamPageSize is the number of people fetched from the store at a time when streaming.
+	streamPageSize = 500
+	// streamPageTimeout bounds writing each page when streaming, instead of the write timeout
+	// of the server bounding the whole stream.
+	streamPageTimeout = 30 * time.Second
+
+	// defaultQueryTimeout bounds queries sent without a timeout, maxQueryTimeout bounds the
+	// timeout requested. Both are short as queries hold the read lock of the graph.
+	defaultQueryTimeout = 2 * time.Second
+	maxQueryTimeout     = 5 * time.Second
+)
+
 type idKey struct{}
 
 func sendErrorResponse(w http.ResponseWriter, err error) {
@@ -1,12 +1,16 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/importer"
 	"github.com/Lambels/relationer/internal/service"
 	"github.com/go-chi/chi/v5"
 )
@@ -14,12 +18,16 @@ import (
 type HandlerService struct {
 	store  service.GraphStore
 	broker service.MessageBroker
+	events service.Subscriber
 }
 
-func NewHandlerService(store service.GraphStore, broker service.MessageBroker) *HandlerService {
+// NewHandlerService creates the rest api over store, mutations publish to broker and
+// GET /events streams from events.
+func NewHandlerService(store service.GraphStore, broker service.MessageBroker, events service.Subscriber) *HandlerService {
 	return &HandlerService{
 		store:  store,
 		broker: broker,
+		events: events,
 	}
 }
 
@@ -27,16 +35,49 @@ func (h *HandlerService) RegisterRouter(mux chi.Router) {
 	// people
 	mux.Get("/people", h.getAll)
 	mux.Post("/people", h.addPerson)
+	mux.Get("/people/search", h.searchPeople)
+
+	// clusters
+	mux.Get("/clusters", h.getClusters)
+
+	// stats
+	mux.Get("/stats", h.getStats)
+
+	// query
+	mux.Post("/query", h.query)
+
+	// events
+	mux.Get("/events", h.streamEvents)
+
+	// centrality
+	mux.Get("/centrality/top", h.getTopCentrality)
+
+	// export
+	mux.Get("/export", h.export)
+	mux.Post("/import", h.importGraph)
+
+	// batch
+	mux.Post("/batch", h.batch)
 
 	// friendship
 	mux.Post("/friendship", h.addFriendship)
+	mux.Delete("/friendship", h.removeFriendship)
 	mux.Get("/friendship/depth/{id1}/{id2}", h.getDepth)
+	mux.Get("/friendship/path/{id1}/{id2}", h.getPath)
+	mux.Get("/friendship/paths/{id1}/{id2}", h.getPaths)
+	mux.Get("/friendship/distance/{id1}/{id2}", h.getWeightedPath)
+	mux.Get("/friendship/mutual/{id1}/{id2}", h.getMutual)
+	mux.Get("/friendship/reciprocity/{id1}/{id2}", h.getReciprocity)
 
 	// id to use parser middleware.
 	mux.Group(func(r chi.Router) {
 		r.Use(idContextValidator)
 
 		r.Get("/people/{id}", h.getPerson)
+		r.Get("/people/{id}/network", h.getNetwork)
+		r.Get("/people/{id}/recommendations", h.getRecommendations)
+		r.Get("/people/{id}/cluster", h.getCluster)
+		r.Get("/people/{id}/centrality", h.getCentrality)
 		r.Delete("/people/{id}", h.removePerson)
 		r.Get("/friendship/{id}", h.getFriendship)
 	})
@@ -68,6 +109,7 @@ func (h *HandlerService) addFriendship(w http.ResponseWriter, r *http.Request) {
 		sendErrorResponse(w, internal.WrapError(err, internal.ECONFLICT, "invalid json body"))
 		return
 	}
+	friendship = friendship.WithDefaults() // published events carry the stored weight and type.
 
 	if err := h.store.AddFriendship(r.Context(), friendship); err != nil {
 		sendErrorResponse(w, err)
@@ -82,6 +124,27 @@ func (h *HandlerService) addFriendship(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusCreated)
 }
 
+func (h *HandlerService) removeFriendship(w http.ResponseWriter, r *http.Request) {
+	var friendship internal.Friendship
+	if err := json.NewDecoder(r.Body).Decode(&friendship); err != nil {
+		sendErrorResponse(w, internal.WrapError(err, internal.ECONFLICT, "invalid json body"))
+		return
+	}
+	friendship = friendship.WithDefaults()
+
+	if err := h.store.RemoveFriendship(r.Context(), friendship); err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	if err := h.broker.DeletedFriendship(r.Context(), friendship); err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *HandlerService) removePerson(w http.ResponseWriter, r *http.Request) {
 	id := r.Context().Value(idKey{}).(int64)
 
@@ -101,7 +164,13 @@ func (h *HandlerService) removePerson(w http.ResponseWriter, r *http.Request) {
 func (h *HandlerService) getFriendship(w http.ResponseWriter, r *http.Request) {
 	id := r.Context().Value(idKey{}).(int64)
 
-	friendship, err := h.store.GetFriendship(r.Context(), id)
+	filter, err := parseEdgeFilter(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	friendship, err := h.store.GetFriendship(r.Context(), id, filter)
 	if err != nil {
 		sendErrorResponse(w, err)
 		return
@@ -110,6 +179,42 @@ func (h *HandlerService) getFriendship(w http.ResponseWriter, r *http.Request) {
 	sendResponse(w, friendship, http.StatusOK)
 }
 
+func (h *HandlerService) findPeople(w http.ResponseWriter, r *http.Request) {
+	var where []internal.Property
+	for _, val := range r.URL.Query()["where"] {
+		prop, err := internal.ParseProperty(val)
+		if err != nil {
+			sendErrorResponse(w, err)
+			return
+		}
+		where = append(where, prop)
+	}
+
+	people, err := h.store.FindPeople(r.Context(), where)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, FindPeopleResponse{Count: len(people), People: people}, http.StatusOK)
+}
+
+func (h *HandlerService) searchPeople(w http.ResponseWriter, r *http.Request) {
+	limit, err := parseIntQuery(r, "limit", 10)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	results, err := h.store.SearchPeople(r.Context(), r.URL.Query().Get("q"), limit)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, SearchPeopleResponse{Results: results}, http.StatusOK)
+}
+
 func (h *HandlerService) getPerson(w http.ResponseWriter, r *http.Request) {
 	id := r.Context().Value(idKey{}).(int64)
 
@@ -122,8 +227,173 @@ func (h *HandlerService) getPerson(w http.ResponseWriter, r *http.Request) {
 	sendResponse(w, person, http.StatusOK)
 }
 
+func (h *HandlerService) getNetwork(w http.ResponseWriter, r *http.Request) {
+	id := r.Context().Value(idKey{}).(int64)
+
+	depth, err := parseIntQuery(r, "depth", 1)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+	withEdges, err := parseBoolQuery(r, "edges", false)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	network, err := h.store.GetNetwork(r.Context(), id, depth, withEdges)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, network, http.StatusOK)
+}
+
+func (h *HandlerService) getRecommendations(w http.ResponseWriter, r *http.Request) {
+	id := r.Context().Value(idKey{}).(int64)
+
+	limit, err := parseIntQuery(r, "limit", 10)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+	algorithm := internal.Algorithm(r.URL.Query().Get("algorithm"))
+	if algorithm == "" {
+		algorithm = internal.CommonNeighbours
+	}
+
+	recommendations, err := h.store.GetRecommendations(r.Context(), id, limit, algorithm)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, GetRecommendationsResponse{Algorithm: algorithm, Recommendations: recommendations}, http.StatusOK)
+}
+
+func (h *HandlerService) getClusters(w http.ResponseWriter, r *http.Request) {
+	kind := parseClusterKind(r)
+
+	clusters, err := h.store.GetClusters(r.Context(), kind)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, GetClustersResponse{Kind: kind, Count: len(clusters), Clusters: clusters}, http.StatusOK)
+}
+
+func (h *HandlerService) getCluster(w http.ResponseWriter, r *http.Request) {
+	id := r.Context().Value(idKey{}).(int64)
+
+	clusters, err := h.store.GetClusters(r.Context(), parseClusterKind(r))
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	for _, cluster := range clusters {
+		for _, member := range cluster.Members {
+			if member == id {
+				sendResponse(w, cluster, http.StatusOK)
+				return
+			}
+		}
+	}
+
+	sendErrorResponse(w, internal.Errorf(internal.ENOTFOUND, "person not found"))
+}
+
+func (h *HandlerService) getCentrality(w http.ResponseWriter, r *http.Request) {
+	id := r.Context().Value(idKey{}).(int64)
+
+	damping, err := parseFloatQuery(r, "damping", internal.DefaultDamping)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	centrality, err := h.store.GetCentrality(r.Context(), id, damping)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, centrality, http.StatusOK)
+}
+
+func (h *HandlerService) getTopCentrality(w http.ResponseWriter, r *http.Request) {
+	metric := internal.Metric(r.URL.Query().Get("metric"))
+	if metric == "" {
+		metric = internal.PageRank
+	}
+	limit, err := parseIntQuery(r, "limit", 10)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+	damping, err := parseFloatQuery(r, "damping", internal.DefaultDamping)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	people, err := h.store.GetTopCentrality(r.Context(), metric, limit, damping)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, GetTopCentralityResponse{Metric: metric, People: people}, http.StatusOK)
+}
+
+// getAll sends the friendships of everyone in one of three modes:
+//   - paginated when the limit or cursor query params are set.
+//   - streamed as newline delimited json when stream=true or ndjson is accepted.
+//   - as a single json array otherwise.
+//
+// when where query params are set the people matching them are sent instead.
 func (h *HandlerService) getAll(w http.ResponseWriter, r *http.Request) {
-	data, err := h.store.GetAll(r.Context())
+	query := r.URL.Query()
+	if query.Has("where") {
+		h.findPeople(w, r)
+		return
+	}
+	filter, err := parseEdgeFilter(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	stream, err := parseBoolQuery(r, "stream", strings.Contains(r.Header.Get("Accept"), ndjsonContentType))
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+	if stream {
+		h.streamAll(w, r, filter)
+		return
+	}
+
+	if query.Has("limit") || query.Has("cursor") {
+		page, err := parsePage(r, defaultPageSize)
+		if err != nil {
+			sendErrorResponse(w, err)
+			return
+		}
+
+		res, err := h.store.GetPage(r.Context(), filter, page)
+		if err != nil {
+			sendErrorResponse(w, err)
+			return
+		}
+
+		sendResponse(w, res, http.StatusOK)
+		return
+	}
+
+	data, err := h.store.GetAll(r.Context(), filter)
 	if err != nil {
 		sendErrorResponse(w, err)
 		return
@@ -132,19 +402,198 @@ func (h *HandlerService) getAll(w http.ResponseWriter, r *http.Request) {
 	sendResponse(w, data, http.StatusOK)
 }
 
+// streamAll writes the friendships one json object per line, fetching them a page at a time
+// so the store isnt held for the whole response. Errors after the first page are written as
+// a final error line since the status is already sent.
+//
+// the write deadline of the server is extended for each page, so streams of large graphs
+// arent cut off while each page is still bound.
+func (h *HandlerService) streamAll(w http.ResponseWriter, r *http.Request, filter internal.EdgeFilter) {
+	page, err := parsePage(r, streamPageSize)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	res, err := h.store.GetPage(r.Context(), filter, page)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", ndjsonContentType)
+	w.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(w)
+	rc := http.NewResponseController(w)
+	for {
+		// writers without deadlines (ie: tests) arent bound anyway.
+		rc.SetWriteDeadline(time.Now().Add(streamPageTimeout))
+		for _, friendship := range res.Friendships {
+			if err := enc.Encode(friendship); err != nil {
+				return // client gone.
+			}
+		}
+		rc.Flush()
+
+		if res.NextCursor == 0 {
+			return
+		}
+		page.Cursor = res.NextCursor
+		if res, err = h.store.GetPage(r.Context(), filter, page); err != nil {
+			enc.Encode(ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+}
+
+func (h *HandlerService) batch(w http.ResponseWriter, r *http.Request) {
+	var ops []internal.BatchOp
+	if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+		sendErrorResponse(w, internal.WrapError(err, internal.ECONFLICT, "invalid json body"))
+		return
+	}
+
+	res, err := h.store.Batch(r.Context(), ops)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	// events are only published once the whole batch is applied.
+	for _, op := range res.Results {
+		switch op.Op {
+		case internal.AddPersonOp:
+			err = h.broker.CreatedPerson(r.Context(), op.Person)
+		case internal.AddFriendshipOp:
+			err = h.broker.CreatedFriendship(r.Context(), *op.Friendship)
+		case internal.RemoveFriendshipOp:
+			err = h.broker.DeletedFriendship(r.Context(), *op.Friendship)
+		case internal.RemovePersonOp:
+			err = h.broker.DeletedPerson(r.Context(), op.Person.ID)
+		}
+		if err != nil {
+			sendErrorResponse(w, err)
+			return
+		}
+	}
+
+	sendResponse(w, res, http.StatusOK)
+}
+
+func (h *HandlerService) getStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.store.GetStats(r.Context())
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, stats, http.StatusOK)
+}
+
+func (h *HandlerService) query(w http.ResponseWriter, r *http.Request) {
+	var req QueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, internal.WrapError(err, internal.ECONFLICT, "invalid json body"))
+		return
+	}
+
+	timeout := defaultQueryTimeout
+	if val := r.URL.Query().Get("timeout"); val != "" {
+		var err error
+		if timeout, err = time.ParseDuration(val); err != nil || timeout <= 0 {
+			sendErrorResponse(w, internal.Errorf(internal.ECONFLICT, "invalid timeout"))
+			return
+		}
+		if timeout > maxQueryTimeout {
+			timeout = maxQueryTimeout
+		}
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	res, err := h.store.Query(ctx, req.Query)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, res, http.StatusOK)
+}
+
+func (h *HandlerService) importGraph(w http.ResponseWriter, r *http.Request) {
+	format := internal.ImportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = internal.ImportJSON
+	}
+
+	imp, err := importer.Read(r.Body, format)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	res, err := h.store.Import(r.Context(), imp)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	// listeners see the import as the equivalent single adds.
+	for _, person := range res.People {
+		if err := h.broker.CreatedPerson(r.Context(), person); err != nil {
+			sendErrorResponse(w, err)
+			return
+		}
+	}
+	for _, friendship := range res.Friendships {
+		if err := h.broker.CreatedFriendship(r.Context(), friendship); err != nil {
+			sendErrorResponse(w, err)
+			return
+		}
+	}
+
+	sendResponse(w, res, http.StatusCreated)
+}
+
+func (h *HandlerService) export(w http.ResponseWriter, r *http.Request) {
+	format := internal.ExportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = internal.GraphML
+	}
+
+	filter, err := parseEdgeFilter(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	// buffer the export so failures can still be sent as json errors.
+	var buf bytes.Buffer
+	if err := h.store.Export(r.Context(), format, filter, &buf); err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="relationer`+format.Extension()+`"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
 func (h *HandlerService) getDepth(w http.ResponseWriter, r *http.Request) {
-	id1, err := strconv.Atoi(chi.URLParam(r, "id1"))
+	id1, id2, err := parseIDPair(r)
 	if err != nil {
-		sendErrorResponse(w, internal.Errorf(internal.ECONFLICT, "invalid id1"))
+		sendErrorResponse(w, err)
 		return
 	}
-	id2, err := strconv.Atoi(chi.URLParam(r, "id2"))
+
+	filter, err := parseEdgeFilter(r)
 	if err != nil {
-		sendErrorResponse(w, internal.Errorf(internal.ECONFLICT, "invalid id2"))
+		sendErrorResponse(w, err)
 		return
 	}
 
-	depth, err := h.store.GetDepth(r.Context(), int64(id1), int64(id2))
+	depth, err := h.store.GetDepth(r.Context(), id1, id2, filter)
 	if err != nil {
 		sendErrorResponse(w, err)
 		return
@@ -153,6 +602,202 @@ func (h *HandlerService) getDepth(w http.ResponseWriter, r *http.Request) {
 	sendResponse(w, GetDepthResponse{Depth: depth}, http.StatusOK)
 }
 
+func (h *HandlerService) getPath(w http.ResponseWriter, r *http.Request) {
+	id1, id2, err := parseIDPair(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	path, err := h.store.GetPath(r.Context(), id1, id2)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, GetPathResponse{Connected: len(path) != 0, Path: path}, http.StatusOK)
+}
+
+func (h *HandlerService) getWeightedPath(w http.ResponseWriter, r *http.Request) {
+	id1, id2, err := parseIDPair(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	path, err := h.store.GetWeightedPath(r.Context(), id1, id2)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, GetWeightedPathResponse{
+		Connected: len(path.Path) != 0,
+		Distance:  path.Distance,
+		Path:      path.Path,
+	}, http.StatusOK)
+}
+
+func (h *HandlerService) getPaths(w http.ResponseWriter, r *http.Request) {
+	id1, id2, err := parseIDPair(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+	length, err := parseIntQuery(r, "length", 4)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+	limit, err := parseIntQuery(r, "limit", 10)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	paths, err := h.store.GetPaths(r.Context(), id1, id2, length, limit)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, GetPathsResponse{Paths: paths}, http.StatusOK)
+}
+
+func (h *HandlerService) getMutual(w http.ResponseWriter, r *http.Request) {
+	id1, id2, err := parseIDPair(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	mutual, err := h.store.GetMutual(r.Context(), id1, id2)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, GetMutualResponse{Count: len(mutual), People: mutual}, http.StatusOK)
+}
+
+func (h *HandlerService) getReciprocity(w http.ResponseWriter, r *http.Request) {
+	id1, id2, err := parseIDPair(r)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	reciprocity, err := h.store.GetReciprocity(r.Context(), id1, id2)
+	if err != nil {
+		sendErrorResponse(w, err)
+		return
+	}
+
+	sendResponse(w, reciprocity, http.StatusOK)
+}
+
+// parseClusterKind parses the kind query param, defaults to weak components.
+func parseClusterKind(r *http.Request) internal.ClusterKind {
+	kind := internal.ClusterKind(r.URL.Query().Get("kind"))
+	if kind == "" {
+		return internal.WeakComponent
+	}
+	return kind
+}
+
+// parseEdgeFilter parses the comma separated relationship types in the types query param,
+// allowing every type when missing, and the RFC3339 asOf query param.
+func parseEdgeFilter(r *http.Request) (internal.EdgeFilter, error) {
+	var filter internal.EdgeFilter
+	for _, t := range strings.Split(r.URL.Query().Get("types"), ",") {
+		if t != "" {
+			filter.Types = append(filter.Types, internal.RelationType(t))
+		}
+	}
+
+	if val := r.URL.Query().Get("asOf"); val != "" {
+		asOf, err := time.Parse(time.RFC3339Nano, val)
+		if err != nil {
+			return filter, internal.Errorf(internal.ECONFLICT, "invalid asOf, expected an RFC3339 timestamp")
+		}
+		filter.AsOf = asOf
+	}
+	return filter, nil
+}
+
+// parsePage parses the cursor and limit query params, def is the limit when missing.
+func parsePage(r *http.Request, def int) (internal.Page, error) {
+	limit, err := parseIntQuery(r, "limit", def)
+	if err != nil {
+		return internal.Page{}, err
+	}
+
+	var cursor int64
+	if val := r.URL.Query().Get("cursor"); val != "" {
+		if cursor, err = strconv.ParseInt(val, 10, 64); err != nil {
+			return internal.Page{}, internal.Errorf(internal.ECONFLICT, "invalid cursor")
+		}
+	}
+
+	return internal.Page{Cursor: cursor, Limit: limit}, nil
+}
+
+// parseIntQuery parses the query param key, def is returned when the param is missing.
+func parseIntQuery(r *http.Request, key string, def int) (int, error) {
+	val := r.URL.Query().Get(key)
+	if val == "" {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, internal.Errorf(internal.ECONFLICT, "invalid %v", key)
+	}
+	return n, nil
+}
+
+// parseFloatQuery parses the query param key, def is returned when the param is missing.
+func parseFloatQuery(r *http.Request, key string, def float64) (float64, error) {
+	val := r.URL.Query().Get(key)
+	if val == "" {
+		return def, nil
+	}
+
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, internal.Errorf(internal.ECONFLICT, "invalid %v", key)
+	}
+	return f, nil
+}
+
+// parseBoolQuery parses the query param key, def is returned when the param is missing.
+func parseBoolQuery(r *http.Request, key string, def bool) (bool, error) {
+	val := r.URL.Query().Get(key)
+	if val == "" {
+		return def, nil
+	}
+
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, internal.Errorf(internal.ECONFLICT, "invalid %v", key)
+	}
+	return b, nil
+}
+
+// parseIDPair parses the id1 and id2 url params.
+func parseIDPair(r *http.Request) (int64, int64, error) {
+	id1, err := strconv.ParseInt(chi.URLParam(r, "id1"), 10, 64)
+	if err != nil {
+		return 0, 0, internal.Errorf(internal.ECONFLICT, "invalid id1")
+	}
+	id2, err := strconv.ParseInt(chi.URLParam(r, "id2"), 10, 64)
+	if err != nil {
+		return 0, 0, internal.Errorf(internal.ECONFLICT, "invalid id2")
+	}
+
+	return id1, id2, nil
+}
+
 func idContextValidator(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		idStr := chi.URLParam(r, "id")
@@ -0,0 +1,338 @@
+package rpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Client is a gRPC client which implements the service.GraphStore
+type Client struct {
+	client pb.RelationerClient
+}
+
+// NewClient creates a client over conn, the connection is owned by the caller.
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{pb.NewRelationerClient(conn)}
+}
+
+// AddPerson stores person, setting its generated id and creation time.
+func (c *Client) AddPerson(ctx context.Context, person *internal.Person) error {
+	res, err := c.client.AddPerson(ctx, personToPB(person))
+	if err != nil {
+		return fromStatus(err, "client.AddPerson")
+	}
+
+	*person = *personFromPB(res)
+	return nil
+}
+
+func (c *Client) RemovePerson(ctx context.Context, id int64) error {
+	_, err := c.client.RemovePerson(ctx, &pb.PersonRequest{Id: id})
+	return fromStatus(err, "client.RemovePerson")
+}
+
+func (c *Client) AddFriendship(ctx context.Context, friendship internal.Friendship) error {
+	_, err := c.client.AddFriendship(ctx, friendshipToPB(friendship))
+	return fromStatus(err, "client.AddFriendship")
+}
+
+func (c *Client) RemoveFriendship(ctx context.Context, friendship internal.Friendship) error {
+	_, err := c.client.RemoveFriendship(ctx, friendshipToPB(friendship))
+	return fromStatus(err, "client.RemoveFriendship")
+}
+
+func (c *Client) GetPerson(ctx context.Context, id int64) (*internal.Person, error) {
+	res, err := c.client.GetPerson(ctx, &pb.PersonRequest{Id: id})
+	if err != nil {
+		return nil, fromStatus(err, "client.GetPerson")
+	}
+
+	return personFromPB(res), nil
+}
+
+func (c *Client) FindPeople(ctx context.Context, where []internal.Property) ([]*internal.Person, error) {
+	req := &pb.FindPeopleRequest{
+		Where: convert(where, func(p internal.Property) *pb.Property {
+			return &pb.Property{Key: p.Key, Value: p.Value}
+		}),
+	}
+
+	res, err := c.client.FindPeople(ctx, req)
+	if err != nil {
+		return nil, fromStatus(err, "client.FindPeople")
+	}
+
+	return peopleFromPB(res), nil
+}
+
+func (c *Client) SearchPeople(ctx context.Context, query string, limit int) ([]internal.SearchResult, error) {
+	res, err := c.client.SearchPeople(ctx, &pb.SearchPeopleRequest{Query: query, Limit: int32(limit)})
+	if err != nil {
+		return nil, fromStatus(err, "client.SearchPeople")
+	}
+
+	return convert(res.GetResults(), searchResultFromPB), nil
+}
+
+func (c *Client) GetFriendship(ctx context.Context, id int64, filter internal.EdgeFilter) (internal.Friendship, error) {
+	res, err := c.client.GetFriendship(ctx, &pb.GetFriendshipRequest{Id: id, Filter: filterToPB(filter)})
+	if err != nil {
+		return internal.Friendship{}, fromStatus(err, "client.GetFriendship")
+	}
+
+	return friendshipFromPB(res), nil
+}
+
+func (c *Client) GetAll(ctx context.Context, filter internal.EdgeFilter) ([]internal.Friendship, error) {
+	friendships := make([]internal.Friendship, 0)
+	err := c.StreamAll(ctx, filter, func(friendship internal.Friendship) error {
+		friendships = append(friendships, friendship)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return friendships, nil
+}
+
+// StreamAll calls fn with the friendships of everyone as they are received, until fn returns
+// an error.
+func (c *Client) StreamAll(ctx context.Context, filter internal.EdgeFilter, fn func(internal.Friendship) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.GetAll(ctx, filterToPB(filter))
+	if err != nil {
+		return fromStatus(err, "client.GetAll")
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fromStatus(err, "stream.Recv")
+		}
+
+		if err := fn(friendshipFromPB(res)); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) GetPage(ctx context.Context, filter internal.EdgeFilter, page internal.Page) (internal.FriendshipPage, error) {
+	req := &pb.GetPageRequest{Filter: filterToPB(filter), Cursor: page.Cursor, Limit: int32(page.Limit)}
+	res, err := c.client.GetPage(ctx, req)
+	if err != nil {
+		return internal.FriendshipPage{}, fromStatus(err, "client.GetPage")
+	}
+
+	return internal.FriendshipPage{
+		Friendships: convert(res.GetFriendships(), friendshipFromPB),
+		NextCursor:  res.GetNextCursor(),
+	}, nil
+}
+
+func (c *Client) GetReciprocity(ctx context.Context, id1, id2 int64) (internal.Reciprocity, error) {
+	res, err := c.client.GetReciprocity(ctx, &pb.PairRequest{Id1: id1, Id2: id2})
+	if err != nil {
+		return internal.Reciprocity{}, fromStatus(err, "client.GetReciprocity")
+	}
+
+	return reciprocityFromPB(res), nil
+}
+
+func (c *Client) GetDepth(ctx context.Context, id1, id2 int64, filter internal.EdgeFilter) (int, error) {
+	res, err := c.client.GetDepth(ctx, &pb.GetDepthRequest{Id1: id1, Id2: id2, Filter: filterToPB(filter)})
+	if err != nil {
+		return 0, fromStatus(err, "client.GetDepth")
+	}
+
+	return int(res.GetDepth()), nil
+}
+
+func (c *Client) GetPath(ctx context.Context, id1, id2 int64) ([]*internal.Person, error) {
+	res, err := c.client.GetPath(ctx, &pb.PairRequest{Id1: id1, Id2: id2})
+	if err != nil {
+		return nil, fromStatus(err, "client.GetPath")
+	}
+
+	return peopleFromPB(res), nil
+}
+
+func (c *Client) GetWeightedPath(ctx context.Context, id1, id2 int64) (internal.WeightedPath, error) {
+	res, err := c.client.GetWeightedPath(ctx, &pb.PairRequest{Id1: id1, Id2: id2})
+	if err != nil {
+		return internal.WeightedPath{}, fromStatus(err, "client.GetWeightedPath")
+	}
+
+	return internal.WeightedPath{Distance: res.GetDistance(), Path: convert(res.GetPath(), personFromPB)}, nil
+}
+
+func (c *Client) GetPaths(ctx context.Context, id1, id2 int64, maxLength, limit int) ([][]*internal.Person, error) {
+	req := &pb.GetPathsRequest{Id1: id1, Id2: id2, MaxLength: int32(maxLength), Limit: int32(limit)}
+	res, err := c.client.GetPaths(ctx, req)
+	if err != nil {
+		return nil, fromStatus(err, "client.GetPaths")
+	}
+
+	return convert(res.GetPaths(), peopleFromPB), nil
+}
+
+func (c *Client) GetMutual(ctx context.Context, id1, id2 int64) ([]*internal.Person, error) {
+	res, err := c.client.GetMutual(ctx, &pb.PairRequest{Id1: id1, Id2: id2})
+	if err != nil {
+		return nil, fromStatus(err, "client.GetMutual")
+	}
+
+	return peopleFromPB(res), nil
+}
+
+func (c *Client) GetNetwork(ctx context.Context, id int64, depth int, withEdges bool) (internal.Network, error) {
+	res, err := c.client.GetNetwork(ctx, &pb.GetNetworkRequest{Id: id, Depth: int32(depth), WithEdges: withEdges})
+	if err != nil {
+		return internal.Network{}, fromStatus(err, "client.GetNetwork")
+	}
+
+	return networkFromPB(res), nil
+}
+
+func (c *Client) GetRecommendations(ctx context.Context, id int64, limit int, algorithm internal.Algorithm) ([]internal.Recommendation, error) {
+	req := &pb.GetRecommendationsRequest{Id: id, Limit: int32(limit), Algorithm: string(algorithm)}
+	res, err := c.client.GetRecommendations(ctx, req)
+	if err != nil {
+		return nil, fromStatus(err, "client.GetRecommendations")
+	}
+
+	return convert(res.GetRecommendations(), func(r *pb.Recommendation) internal.Recommendation {
+		return internal.Recommendation{Person: personFromPB(r.GetPerson()), Score: r.GetScore()}
+	}), nil
+}
+
+func (c *Client) GetClusters(ctx context.Context, kind internal.ClusterKind) ([]internal.Cluster, error) {
+	res, err := c.client.GetClusters(ctx, &pb.GetClustersRequest{Kind: string(kind)})
+	if err != nil {
+		return nil, fromStatus(err, "client.GetClusters")
+	}
+
+	return convert(res.GetClusters(), clusterFromPB), nil
+}
+
+func (c *Client) GetStats(ctx context.Context) (internal.Stats, error) {
+	res, err := c.client.GetStats(ctx, &emptypb.Empty{})
+	if err != nil {
+		return internal.Stats{}, fromStatus(err, "client.GetStats")
+	}
+
+	return statsFromPB(res), nil
+}
+
+// Query runs q on the server, the deadline of ctx bounds the query.
+func (c *Client) Query(ctx context.Context, q string) (internal.QueryResult, error) {
+	res, err := c.client.Query(ctx, &pb.QueryRequest{Query: q})
+	if err != nil {
+		return internal.QueryResult{}, fromStatus(err, "client.Query")
+	}
+
+	return queryResultFromPB(res), nil
+}
+
+func (c *Client) GetCentrality(ctx context.Context, id int64, damping float64) (internal.Centrality, error) {
+	res, err := c.client.GetCentrality(ctx, &pb.GetCentralityRequest{Id: id, Damping: damping})
+	if err != nil {
+		return internal.Centrality{}, fromStatus(err, "client.GetCentrality")
+	}
+
+	return centralityFromPB(res), nil
+}
+
+func (c *Client) GetTopCentrality(ctx context.Context, metric internal.Metric, limit int, damping float64) ([]internal.Ranked, error) {
+	req := &pb.GetTopCentralityRequest{Metric: string(metric), Limit: int32(limit), Damping: damping}
+	res, err := c.client.GetTopCentrality(ctx, req)
+	if err != nil {
+		return nil, fromStatus(err, "client.GetTopCentrality")
+	}
+
+	return convert(res.GetRanked(), func(r *pb.Ranked) internal.Ranked {
+		return internal.Ranked{Person: personFromPB(r.GetPerson()), Score: r.GetScore()}
+	}), nil
+}
+
+// Export writes the chunks of the export to w as they arrive.
+func (c *Client) Export(ctx context.Context, format internal.ExportFormat, filter internal.EdgeFilter, w io.Writer) error {
+	stream, err := c.client.Export(ctx, &pb.ExportRequest{Format: string(format), Filter: filterToPB(filter)})
+	if err != nil {
+		return fromStatus(err, "client.Export")
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fromStatus(err, "stream.Recv")
+		}
+
+		if _, err := w.Write(res.GetData()); err != nil {
+			return internal.WrapError(err, internal.EINTERNAL, "w.Write")
+		}
+	}
+}
+
+func (c *Client) Import(ctx context.Context, imp *internal.Import) (internal.ImportResult, error) {
+	res, err := c.client.Import(ctx, importToPB(imp))
+	if err != nil {
+		return internal.ImportResult{}, fromStatus(err, "client.Import")
+	}
+
+	return importResultFromPB(res), nil
+}
+
+func (c *Client) Batch(ctx context.Context, ops []internal.BatchOp) (internal.BatchResult, error) {
+	res, err := c.client.Batch(ctx, &pb.BatchRequest{Ops: convert(ops, batchOpToPB)})
+	if err != nil {
+		return internal.BatchResult{}, fromStatus(err, "client.Batch")
+	}
+
+	return batchResultFromPB(res), nil
+}
+
+// Watch streams the events with the routing keys published once it returns, every event when
+// no keys are provided. The channel is closed once ctx is done or the stream ends.
+func (c *Client) Watch(ctx context.Context, keys ...string) (<-chan internal.Event, error) {
+	stream, err := c.client.Watch(ctx, &pb.WatchRequest{Keys: keys})
+	if err != nil {
+		return nil, fromStatus(err, "client.Watch")
+	}
+	// the server sends the headers once subscribed, streams rejected end without any.
+	if md, err := stream.Header(); err != nil || md == nil {
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		return nil, fromStatus(err, "client.Watch")
+	}
+
+	events := make(chan internal.Event)
+	go func() {
+		defer close(events)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			select {
+			case events <- eventFromPB(res):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
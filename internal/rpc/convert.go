@@ -0,0 +1,473 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/rpc/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// convert maps fn over in, keeping nil slices nil.
+func convert[T, U any](in []T, fn func(T) U) []U {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]U, len(in))
+	for i, v := range in {
+		out[i] = fn(v)
+	}
+	return out
+}
+
+// timeToPB leaves the zero time unset.
+func timeToPB(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timeFromPB(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func personToPB(person *internal.Person) *pb.Person {
+	if person == nil {
+		return nil
+	}
+
+	return &pb.Person{
+		Id:         person.ID,
+		Name:       person.Name,
+		CreatedAt:  timeToPB(person.CreatedAt),
+		Properties: person.Properties,
+	}
+}
+
+func personFromPB(person *pb.Person) *internal.Person {
+	if person == nil {
+		return nil
+	}
+
+	return &internal.Person{
+		ID:         person.GetId(),
+		Name:       person.GetName(),
+		CreatedAt:  timeFromPB(person.GetCreatedAt()),
+		Properties: person.GetProperties(),
+	}
+}
+
+func peopleToPB(people []*internal.Person) *pb.People {
+	return &pb.People{People: convert(people, personToPB)}
+}
+
+func peopleFromPB(people *pb.People) []*internal.Person {
+	return convert(people.GetPeople(), personFromPB)
+}
+
+func typesToPB(types []internal.RelationType) []string {
+	return convert(types, func(t internal.RelationType) string { return string(t) })
+}
+
+func typesFromPB(types []string) []internal.RelationType {
+	return convert(types, func(t string) internal.RelationType { return internal.RelationType(t) })
+}
+
+func friendshipToPB(friendship internal.Friendship) *pb.Friendship {
+	return &pb.Friendship{
+		P1:      personToPB(friendship.P1),
+		With:    friendship.With,
+		Weights: friendship.Weights,
+		Types:   typesToPB(friendship.Types),
+		Mutual:  friendship.Mutual,
+	}
+}
+
+func friendshipFromPB(friendship *pb.Friendship) internal.Friendship {
+	return internal.Friendship{
+		P1:      personFromPB(friendship.GetP1()),
+		With:    friendship.GetWith(),
+		Weights: friendship.GetWeights(),
+		Types:   typesFromPB(friendship.GetTypes()),
+		Mutual:  friendship.GetMutual(),
+	}
+}
+
+// friendshipPtrToPB converts the optional friendships of results and events.
+func friendshipPtrToPB(friendship *internal.Friendship) *pb.Friendship {
+	if friendship == nil {
+		return nil
+	}
+	return friendshipToPB(*friendship)
+}
+
+func friendshipPtrFromPB(friendship *pb.Friendship) *internal.Friendship {
+	if friendship == nil {
+		return nil
+	}
+	res := friendshipFromPB(friendship)
+	return &res
+}
+
+func filterToPB(filter internal.EdgeFilter) *pb.EdgeFilter {
+	return &pb.EdgeFilter{
+		Types: typesToPB(filter.Types),
+		AsOf:  timeToPB(filter.AsOf),
+	}
+}
+
+func filterFromPB(filter *pb.EdgeFilter) internal.EdgeFilter {
+	return internal.EdgeFilter{
+		Types: typesFromPB(filter.GetTypes()),
+		AsOf:  timeFromPB(filter.GetAsOf()),
+	}
+}
+
+func reciprocityToPB(reciprocity internal.Reciprocity) *pb.Reciprocity {
+	return &pb.Reciprocity{
+		P1:           reciprocity.P1,
+		P2:           reciprocity.P2,
+		Forward:      reciprocity.Forward,
+		Backward:     reciprocity.Backward,
+		Reciprocated: reciprocity.Reciprocated,
+	}
+}
+
+func reciprocityFromPB(reciprocity *pb.Reciprocity) internal.Reciprocity {
+	return internal.Reciprocity{
+		P1:           reciprocity.GetP1(),
+		P2:           reciprocity.GetP2(),
+		Forward:      reciprocity.GetForward(),
+		Backward:     reciprocity.GetBackward(),
+		Reciprocated: reciprocity.GetReciprocated(),
+	}
+}
+
+func searchResultToPB(res internal.SearchResult) *pb.SearchResult {
+	return &pb.SearchResult{Person: personToPB(res.Person), Score: res.Score}
+}
+
+func searchResultFromPB(res *pb.SearchResult) internal.SearchResult {
+	return internal.SearchResult{Person: personFromPB(res.GetPerson()), Score: res.GetScore()}
+}
+
+func networkToPB(network internal.Network) *pb.Network {
+	return &pb.Network{
+		Center: personToPB(network.Center),
+		People: convert(network.People, func(n internal.Neighbour) *pb.Neighbour {
+			return &pb.Neighbour{Person: personToPB(n.Person), Distance: int32(n.Distance)}
+		}),
+		Edges: convert(network.Edges, friendshipToPB),
+	}
+}
+
+func networkFromPB(network *pb.Network) internal.Network {
+	return internal.Network{
+		Center: personFromPB(network.GetCenter()),
+		People: convert(network.GetPeople(), func(n *pb.Neighbour) internal.Neighbour {
+			return internal.Neighbour{Person: personFromPB(n.GetPerson()), Distance: int(n.GetDistance())}
+		}),
+		Edges: convert(network.GetEdges(), friendshipFromPB),
+	}
+}
+
+func clusterToPB(cluster internal.Cluster) *pb.Cluster {
+	return &pb.Cluster{Id: int32(cluster.ID), Size: int32(cluster.Size), Members: cluster.Members}
+}
+
+func clusterFromPB(cluster *pb.Cluster) internal.Cluster {
+	return internal.Cluster{ID: int(cluster.GetId()), Size: int(cluster.GetSize()), Members: cluster.GetMembers()}
+}
+
+func degreesToPB(degrees []internal.DegreeCount) []*pb.DegreeCount {
+	return convert(degrees, func(d internal.DegreeCount) *pb.DegreeCount {
+		return &pb.DegreeCount{Degree: int32(d.Degree), People: int32(d.People)}
+	})
+}
+
+func degreesFromPB(degrees []*pb.DegreeCount) []internal.DegreeCount {
+	return convert(degrees, func(d *pb.DegreeCount) internal.DegreeCount {
+		return internal.DegreeCount{Degree: int(d.GetDegree()), People: int(d.GetPeople())}
+	})
+}
+
+func statsToPB(stats internal.Stats) *pb.Stats {
+	relationships := make(map[string]int32, len(stats.Relationships))
+	for t, n := range stats.Relationships {
+		relationships[string(t)] = int32(n)
+	}
+
+	return &pb.Stats{
+		People:           int32(stats.People),
+		Friendships:      int32(stats.Friendships),
+		Relationships:    relationships,
+		Isolated:         int32(stats.Isolated),
+		Density:          stats.Density,
+		MeanDegree:       stats.MeanDegree,
+		InDegrees:        degreesToPB(stats.InDegrees),
+		OutDegrees:       degreesToPB(stats.OutDegrees),
+		Components:       int32(stats.Components),
+		StrongComponents: int32(stats.StrongComponents),
+		LargestComponent: int32(stats.LargestComponent),
+		Diameter:         int32(stats.Diameter),
+		Radius:           int32(stats.Radius),
+		Estimated:        stats.Estimated,
+		Clustering:       stats.Clustering,
+	}
+}
+
+func statsFromPB(stats *pb.Stats) internal.Stats {
+	relationships := make(map[internal.RelationType]int, len(stats.GetRelationships()))
+	for t, n := range stats.GetRelationships() {
+		relationships[internal.RelationType(t)] = int(n)
+	}
+
+	return internal.Stats{
+		People:           int(stats.GetPeople()),
+		Friendships:      int(stats.GetFriendships()),
+		Relationships:    relationships,
+		Isolated:         int(stats.GetIsolated()),
+		Density:          stats.GetDensity(),
+		MeanDegree:       stats.GetMeanDegree(),
+		InDegrees:        degreesFromPB(stats.GetInDegrees()),
+		OutDegrees:       degreesFromPB(stats.GetOutDegrees()),
+		Components:       int(stats.GetComponents()),
+		StrongComponents: int(stats.GetStrongComponents()),
+		LargestComponent: int(stats.GetLargestComponent()),
+		Diameter:         int(stats.GetDiameter()),
+		Radius:           int(stats.GetRadius()),
+		Estimated:        stats.GetEstimated(),
+		Clustering:       stats.GetClustering(),
+	}
+}
+
+// queryValueToPB converts a value returned by a query, ids (int64) are sent as numbers like
+// over json.
+func queryValueToPB(v internal.QueryValue) *pb.QueryValue {
+	res := &pb.QueryValue{}
+	switch {
+	case v.Person != nil:
+		res.Value = &pb.QueryValue_Person{Person: personToPB(v.Person)}
+	case v.Relationship != nil:
+		res.Value = &pb.QueryValue_Relationship{Relationship: &pb.Relationship{
+			P1:     v.Relationship.P1,
+			P2:     v.Relationship.P2,
+			Type:   string(v.Relationship.Type),
+			Weight: v.Relationship.Weight,
+		}}
+	}
+
+	switch value := v.Value.(type) {
+	case string:
+		res.Value = &pb.QueryValue_StringValue{StringValue: value}
+	case float64:
+		res.Value = &pb.QueryValue_NumberValue{NumberValue: value}
+	case int64:
+		res.Value = &pb.QueryValue_NumberValue{NumberValue: float64(value)}
+	case bool:
+		res.Value = &pb.QueryValue_BoolValue{BoolValue: value}
+	}
+	return res
+}
+
+func queryValueFromPB(v *pb.QueryValue) internal.QueryValue {
+	switch value := v.GetValue().(type) {
+	case *pb.QueryValue_Person:
+		return internal.QueryValue{Person: personFromPB(value.Person)}
+	case *pb.QueryValue_Relationship:
+		return internal.QueryValue{Relationship: &internal.Relationship{
+			P1:     value.Relationship.GetP1(),
+			P2:     value.Relationship.GetP2(),
+			Type:   internal.RelationType(value.Relationship.GetType()),
+			Weight: value.Relationship.GetWeight(),
+		}}
+	case *pb.QueryValue_StringValue:
+		return internal.QueryValue{Value: value.StringValue}
+	case *pb.QueryValue_NumberValue:
+		return internal.QueryValue{Value: value.NumberValue}
+	case *pb.QueryValue_BoolValue:
+		return internal.QueryValue{Value: value.BoolValue}
+	}
+	return internal.QueryValue{}
+}
+
+func queryResultToPB(res internal.QueryResult) *pb.QueryResult {
+	return &pb.QueryResult{
+		Columns: res.Columns,
+		Rows: convert(res.Rows, func(row []internal.QueryValue) *pb.QueryRow {
+			return &pb.QueryRow{Values: convert(row, queryValueToPB)}
+		}),
+	}
+}
+
+func queryResultFromPB(res *pb.QueryResult) internal.QueryResult {
+	return internal.QueryResult{
+		Columns: res.GetColumns(),
+		Rows: convert(res.GetRows(), func(row *pb.QueryRow) []internal.QueryValue {
+			return convert(row.GetValues(), queryValueFromPB)
+		}),
+	}
+}
+
+func centralityToPB(centrality internal.Centrality) *pb.Centrality {
+	return &pb.Centrality{
+		Person:      personToPB(centrality.Person),
+		InDegree:    int32(centrality.InDegree),
+		OutDegree:   int32(centrality.OutDegree),
+		Closeness:   centrality.Closeness,
+		Betweenness: centrality.Betweenness,
+		Pagerank:    centrality.PageRank,
+	}
+}
+
+func centralityFromPB(centrality *pb.Centrality) internal.Centrality {
+	return internal.Centrality{
+		Person:      personFromPB(centrality.GetPerson()),
+		InDegree:    int(centrality.GetInDegree()),
+		OutDegree:   int(centrality.GetOutDegree()),
+		Closeness:   centrality.GetCloseness(),
+		Betweenness: centrality.GetBetweenness(),
+		PageRank:    centrality.GetPagerank(),
+	}
+}
+
+func importToPB(imp *internal.Import) *pb.ImportRequest {
+	return &pb.ImportRequest{
+		Nodes: convert(imp.Nodes, func(n internal.ImportNode) *pb.ImportNode {
+			return &pb.ImportNode{Id: n.ID, Name: n.Name, Properties: n.Properties, Row: int32(n.Row)}
+		}),
+		Edges: convert(imp.Edges, func(e internal.ImportEdge) *pb.ImportEdge {
+			return &pb.ImportEdge{
+				Source: e.Source,
+				Target: e.Target,
+				Weight: e.Weight,
+				Type:   string(e.Type),
+				Mutual: e.Mutual,
+				Row:    int32(e.Row),
+			}
+		}),
+	}
+}
+
+func importFromPB(imp *pb.ImportRequest) *internal.Import {
+	return &internal.Import{
+		Nodes: convert(imp.GetNodes(), func(n *pb.ImportNode) internal.ImportNode {
+			return internal.ImportNode{ID: n.GetId(), Name: n.GetName(), Properties: n.GetProperties(), Row: int(n.GetRow())}
+		}),
+		Edges: convert(imp.GetEdges(), func(e *pb.ImportEdge) internal.ImportEdge {
+			return internal.ImportEdge{
+				Source: e.GetSource(),
+				Target: e.GetTarget(),
+				Weight: e.GetWeight(),
+				Type:   internal.RelationType(e.GetType()),
+				Mutual: e.GetMutual(),
+				Row:    int(e.GetRow()),
+			}
+		}),
+	}
+}
+
+func importResultToPB(res internal.ImportResult) *pb.ImportResult {
+	return &pb.ImportResult{
+		People:      convert(res.People, personToPB),
+		Friendships: convert(res.Friendships, friendshipToPB),
+		Ids:         res.IDs,
+		Errors: convert(res.Errors, func(e internal.ImportError) *pb.ImportError {
+			return &pb.ImportError{Row: int32(e.Row), Kind: e.Kind, Error: e.Error}
+		}),
+	}
+}
+
+func importResultFromPB(res *pb.ImportResult) internal.ImportResult {
+	return internal.ImportResult{
+		People:      convert(res.GetPeople(), personFromPB),
+		Friendships: convert(res.GetFriendships(), friendshipFromPB),
+		IDs:         res.GetIds(),
+		Errors: convert(res.GetErrors(), func(e *pb.ImportError) internal.ImportError {
+			return internal.ImportError{Row: int(e.GetRow()), Kind: e.GetKind(), Error: e.GetError()}
+		}),
+	}
+}
+
+func refToPB(ref internal.PersonRef) *pb.PersonRef {
+	return &pb.PersonRef{Id: ref.ID, Ref: ref.Ref}
+}
+
+func refFromPB(ref *pb.PersonRef) internal.PersonRef {
+	return internal.PersonRef{ID: ref.GetId(), Ref: ref.GetRef()}
+}
+
+func batchOpToPB(op internal.BatchOp) *pb.BatchOp {
+	return &pb.BatchOp{
+		Op:     string(op.Op),
+		Ref:    op.Ref,
+		Person: personToPB(op.Person),
+		From:   refToPB(op.From),
+		To:     refToPB(op.To),
+		Weight: op.Weight,
+		Type:   string(op.Type),
+		Mutual: op.Mutual,
+		Target: refToPB(op.Target),
+	}
+}
+
+func batchOpFromPB(op *pb.BatchOp) internal.BatchOp {
+	return internal.BatchOp{
+		Op:     internal.BatchOpKind(op.GetOp()),
+		Ref:    op.GetRef(),
+		Person: personFromPB(op.GetPerson()),
+		From:   refFromPB(op.GetFrom()),
+		To:     refFromPB(op.GetTo()),
+		Weight: op.GetWeight(),
+		Type:   internal.RelationType(op.GetType()),
+		Mutual: op.GetMutual(),
+		Target: refFromPB(op.GetTarget()),
+	}
+}
+
+func batchResultToPB(res internal.BatchResult) *pb.BatchResult {
+	return &pb.BatchResult{
+		Results: convert(res.Results, func(r internal.BatchOpResult) *pb.BatchOpResult {
+			return &pb.BatchOpResult{Op: string(r.Op), Person: personToPB(r.Person), Friendship: friendshipPtrToPB(r.Friendship)}
+		}),
+		Ids: res.IDs,
+	}
+}
+
+func batchResultFromPB(res *pb.BatchResult) internal.BatchResult {
+	return internal.BatchResult{
+		Results: convert(res.GetResults(), func(r *pb.BatchOpResult) internal.BatchOpResult {
+			return internal.BatchOpResult{
+				Op:         internal.BatchOpKind(r.GetOp()),
+				Person:     personFromPB(r.GetPerson()),
+				Friendship: friendshipPtrFromPB(r.GetFriendship()),
+			}
+		}),
+		IDs: res.GetIds(),
+	}
+}
+
+func eventToPB(event internal.Event) *pb.Event {
+	return &pb.Event{
+		Key:        event.Key,
+		Time:       timeToPB(event.Time),
+		Person:     personToPB(event.Person),
+		Friendship: friendshipPtrToPB(event.Friendship),
+		Id:         event.ID,
+	}
+}
+
+func eventFromPB(event *pb.Event) internal.Event {
+	return internal.Event{
+		Key:        event.GetKey(),
+		Time:       timeFromPB(event.GetTime()),
+		Person:     personFromPB(event.GetPerson()),
+		Friendship: friendshipPtrFromPB(event.GetFriendship()),
+		ID:         event.GetId(),
+	}
+}
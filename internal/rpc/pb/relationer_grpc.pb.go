@@ -0,0 +1,1176 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: relationer/v1/relationer.proto
+
+// relationer.v1 is the gRPC api of relationer, serving the operations of the rest api and
+// streaming the changes to the graph.
+//
+// errors are reported with the status codes: INVALID_ARGUMENT for invalid requests,
+// NOT_FOUND for missing people, ALREADY_EXISTS for conflicts and INTERNAL otherwise.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Relationer_AddPerson_FullMethodName          = "/relationer.v1.Relationer/AddPerson"
+	Relationer_RemovePerson_FullMethodName       = "/relationer.v1.Relationer/RemovePerson"
+	Relationer_AddFriendship_FullMethodName      = "/relationer.v1.Relationer/AddFriendship"
+	Relationer_RemoveFriendship_FullMethodName   = "/relationer.v1.Relationer/RemoveFriendship"
+	Relationer_GetPerson_FullMethodName          = "/relationer.v1.Relationer/GetPerson"
+	Relationer_FindPeople_FullMethodName         = "/relationer.v1.Relationer/FindPeople"
+	Relationer_SearchPeople_FullMethodName       = "/relationer.v1.Relationer/SearchPeople"
+	Relationer_GetFriendship_FullMethodName      = "/relationer.v1.Relationer/GetFriendship"
+	Relationer_GetAll_FullMethodName             = "/relationer.v1.Relationer/GetAll"
+	Relationer_GetPage_FullMethodName            = "/relationer.v1.Relationer/GetPage"
+	Relationer_GetReciprocity_FullMethodName     = "/relationer.v1.Relationer/GetReciprocity"
+	Relationer_GetDepth_FullMethodName           = "/relationer.v1.Relationer/GetDepth"
+	Relationer_GetPath_FullMethodName            = "/relationer.v1.Relationer/GetPath"
+	Relationer_GetWeightedPath_FullMethodName    = "/relationer.v1.Relationer/GetWeightedPath"
+	Relationer_GetPaths_FullMethodName           = "/relationer.v1.Relationer/GetPaths"
+	Relationer_GetMutual_FullMethodName          = "/relationer.v1.Relationer/GetMutual"
+	Relationer_GetNetwork_FullMethodName         = "/relationer.v1.Relationer/GetNetwork"
+	Relationer_GetRecommendations_FullMethodName = "/relationer.v1.Relationer/GetRecommendations"
+	Relationer_GetClusters_FullMethodName        = "/relationer.v1.Relationer/GetClusters"
+	Relationer_GetStats_FullMethodName           = "/relationer.v1.Relationer/GetStats"
+	Relationer_Query_FullMethodName              = "/relationer.v1.Relationer/Query"
+	Relationer_GetCentrality_FullMethodName      = "/relationer.v1.Relationer/GetCentrality"
+	Relationer_GetTopCentrality_FullMethodName   = "/relationer.v1.Relationer/GetTopCentrality"
+	Relationer_Export_FullMethodName             = "/relationer.v1.Relationer/Export"
+	Relationer_Import_FullMethodName             = "/relationer.v1.Relationer/Import"
+	Relationer_Batch_FullMethodName              = "/relationer.v1.Relationer/Batch"
+	Relationer_Watch_FullMethodName              = "/relationer.v1.Relationer/Watch"
+)
+
+// RelationerClient is the client API for Relationer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RelationerClient interface {
+	// AddPerson stores a person, returning it with its generated id.
+	AddPerson(ctx context.Context, in *Person, opts ...grpc.CallOption) (*Person, error)
+	RemovePerson(ctx context.Context, in *PersonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddFriendship(ctx context.Context, in *Friendship, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveFriendship(ctx context.Context, in *Friendship, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPerson(ctx context.Context, in *PersonRequest, opts ...grpc.CallOption) (*Person, error)
+	FindPeople(ctx context.Context, in *FindPeopleRequest, opts ...grpc.CallOption) (*People, error)
+	SearchPeople(ctx context.Context, in *SearchPeopleRequest, opts ...grpc.CallOption) (*SearchPeopleResponse, error)
+	GetFriendship(ctx context.Context, in *GetFriendshipRequest, opts ...grpc.CallOption) (*Friendship, error)
+	// GetAll streams the friendships of every person, ordered by person id.
+	GetAll(ctx context.Context, in *EdgeFilter, opts ...grpc.CallOption) (Relationer_GetAllClient, error)
+	GetPage(ctx context.Context, in *GetPageRequest, opts ...grpc.CallOption) (*FriendshipPage, error)
+	GetReciprocity(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*Reciprocity, error)
+	// GetDepth returns the number of hops between two people, -1 when they arent connected.
+	GetDepth(ctx context.Context, in *GetDepthRequest, opts ...grpc.CallOption) (*GetDepthResponse, error)
+	GetPath(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*People, error)
+	GetWeightedPath(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*WeightedPath, error)
+	GetPaths(ctx context.Context, in *GetPathsRequest, opts ...grpc.CallOption) (*GetPathsResponse, error)
+	GetMutual(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*People, error)
+	GetNetwork(ctx context.Context, in *GetNetworkRequest, opts ...grpc.CallOption) (*Network, error)
+	GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error)
+	GetClusters(ctx context.Context, in *GetClustersRequest, opts ...grpc.CallOption) (*GetClustersResponse, error)
+	GetStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Stats, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResult, error)
+	GetCentrality(ctx context.Context, in *GetCentralityRequest, opts ...grpc.CallOption) (*Centrality, error)
+	GetTopCentrality(ctx context.Context, in *GetTopCentralityRequest, opts ...grpc.CallOption) (*GetTopCentralityResponse, error)
+	// Export streams the graph encoded in a file format, in chunks.
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Relationer_ExportClient, error)
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResult, error)
+	// Batch applies the operations in a single transaction, either all of them are applied or
+	// none.
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResult, error)
+	// Watch streams the changes to the graph made from now on, the stream ends if the client
+	// falls too far behind.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Relationer_WatchClient, error)
+}
+
+type relationerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationerClient(cc grpc.ClientConnInterface) RelationerClient {
+	return &relationerClient{cc}
+}
+
+func (c *relationerClient) AddPerson(ctx context.Context, in *Person, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, Relationer_AddPerson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) RemovePerson(ctx context.Context, in *PersonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Relationer_RemovePerson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) AddFriendship(ctx context.Context, in *Friendship, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Relationer_AddFriendship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) RemoveFriendship(ctx context.Context, in *Friendship, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Relationer_RemoveFriendship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetPerson(ctx context.Context, in *PersonRequest, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, Relationer_GetPerson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) FindPeople(ctx context.Context, in *FindPeopleRequest, opts ...grpc.CallOption) (*People, error) {
+	out := new(People)
+	err := c.cc.Invoke(ctx, Relationer_FindPeople_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) SearchPeople(ctx context.Context, in *SearchPeopleRequest, opts ...grpc.CallOption) (*SearchPeopleResponse, error) {
+	out := new(SearchPeopleResponse)
+	err := c.cc.Invoke(ctx, Relationer_SearchPeople_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetFriendship(ctx context.Context, in *GetFriendshipRequest, opts ...grpc.CallOption) (*Friendship, error) {
+	out := new(Friendship)
+	err := c.cc.Invoke(ctx, Relationer_GetFriendship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetAll(ctx context.Context, in *EdgeFilter, opts ...grpc.CallOption) (Relationer_GetAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Relationer_ServiceDesc.Streams[0], Relationer_GetAll_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relationerGetAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Relationer_GetAllClient interface {
+	Recv() (*Friendship, error)
+	grpc.ClientStream
+}
+
+type relationerGetAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *relationerGetAllClient) Recv() (*Friendship, error) {
+	m := new(Friendship)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *relationerClient) GetPage(ctx context.Context, in *GetPageRequest, opts ...grpc.CallOption) (*FriendshipPage, error) {
+	out := new(FriendshipPage)
+	err := c.cc.Invoke(ctx, Relationer_GetPage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetReciprocity(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*Reciprocity, error) {
+	out := new(Reciprocity)
+	err := c.cc.Invoke(ctx, Relationer_GetReciprocity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetDepth(ctx context.Context, in *GetDepthRequest, opts ...grpc.CallOption) (*GetDepthResponse, error) {
+	out := new(GetDepthResponse)
+	err := c.cc.Invoke(ctx, Relationer_GetDepth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetPath(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*People, error) {
+	out := new(People)
+	err := c.cc.Invoke(ctx, Relationer_GetPath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetWeightedPath(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*WeightedPath, error) {
+	out := new(WeightedPath)
+	err := c.cc.Invoke(ctx, Relationer_GetWeightedPath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetPaths(ctx context.Context, in *GetPathsRequest, opts ...grpc.CallOption) (*GetPathsResponse, error) {
+	out := new(GetPathsResponse)
+	err := c.cc.Invoke(ctx, Relationer_GetPaths_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetMutual(ctx context.Context, in *PairRequest, opts ...grpc.CallOption) (*People, error) {
+	out := new(People)
+	err := c.cc.Invoke(ctx, Relationer_GetMutual_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetNetwork(ctx context.Context, in *GetNetworkRequest, opts ...grpc.CallOption) (*Network, error) {
+	out := new(Network)
+	err := c.cc.Invoke(ctx, Relationer_GetNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error) {
+	out := new(GetRecommendationsResponse)
+	err := c.cc.Invoke(ctx, Relationer_GetRecommendations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetClusters(ctx context.Context, in *GetClustersRequest, opts ...grpc.CallOption) (*GetClustersResponse, error) {
+	out := new(GetClustersResponse)
+	err := c.cc.Invoke(ctx, Relationer_GetClusters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Stats, error) {
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, Relationer_GetStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResult, error) {
+	out := new(QueryResult)
+	err := c.cc.Invoke(ctx, Relationer_Query_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetCentrality(ctx context.Context, in *GetCentralityRequest, opts ...grpc.CallOption) (*Centrality, error) {
+	out := new(Centrality)
+	err := c.cc.Invoke(ctx, Relationer_GetCentrality_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) GetTopCentrality(ctx context.Context, in *GetTopCentralityRequest, opts ...grpc.CallOption) (*GetTopCentralityResponse, error) {
+	out := new(GetTopCentralityResponse)
+	err := c.cc.Invoke(ctx, Relationer_GetTopCentrality_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Relationer_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Relationer_ServiceDesc.Streams[1], Relationer_Export_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relationerExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Relationer_ExportClient interface {
+	Recv() (*ExportChunk, error)
+	grpc.ClientStream
+}
+
+type relationerExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *relationerExportClient) Recv() (*ExportChunk, error) {
+	m := new(ExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *relationerClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResult, error) {
+	out := new(ImportResult)
+	err := c.cc.Invoke(ctx, Relationer_Import_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResult, error) {
+	out := new(BatchResult)
+	err := c.cc.Invoke(ctx, Relationer_Batch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationerClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Relationer_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Relationer_ServiceDesc.Streams[2], Relationer_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relationerWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Relationer_WatchClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type relationerWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *relationerWatchClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RelationerServer is the server API for Relationer service.
+// All implementations must embed UnimplementedRelationerServer
+// for forward compatibility
+type RelationerServer interface {
+	// AddPerson stores a person, returning it with its generated id.
+	AddPerson(context.Context, *Person) (*Person, error)
+	RemovePerson(context.Context, *PersonRequest) (*emptypb.Empty, error)
+	AddFriendship(context.Context, *Friendship) (*emptypb.Empty, error)
+	RemoveFriendship(context.Context, *Friendship) (*emptypb.Empty, error)
+	GetPerson(context.Context, *PersonRequest) (*Person, error)
+	FindPeople(context.Context, *FindPeopleRequest) (*People, error)
+	SearchPeople(context.Context, *SearchPeopleRequest) (*SearchPeopleResponse, error)
+	GetFriendship(context.Context, *GetFriendshipRequest) (*Friendship, error)
+	// GetAll streams the friendships of every person, ordered by person id.
+	GetAll(*EdgeFilter, Relationer_GetAllServer) error
+	GetPage(context.Context, *GetPageRequest) (*FriendshipPage, error)
+	GetReciprocity(context.Context, *PairRequest) (*Reciprocity, error)
+	// GetDepth returns the number of hops between two people, -1 when they arent connected.
+	GetDepth(context.Context, *GetDepthRequest) (*GetDepthResponse, error)
+	GetPath(context.Context, *PairRequest) (*People, error)
+	GetWeightedPath(context.Context, *PairRequest) (*WeightedPath, error)
+	GetPaths(context.Context, *GetPathsRequest) (*GetPathsResponse, error)
+	GetMutual(context.Context, *PairRequest) (*People, error)
+	GetNetwork(context.Context, *GetNetworkRequest) (*Network, error)
+	GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error)
+	GetClusters(context.Context, *GetClustersRequest) (*GetClustersResponse, error)
+	GetStats(context.Context, *emptypb.Empty) (*Stats, error)
+	Query(context.Context, *QueryRequest) (*QueryResult, error)
+	GetCentrality(context.Context, *GetCentralityRequest) (*Centrality, error)
+	GetTopCentrality(context.Context, *GetTopCentralityRequest) (*GetTopCentralityResponse, error)
+	// Export streams the graph encoded in a file format, in chunks.
+	Export(*ExportRequest, Relationer_ExportServer) error
+	Import(context.Context, *ImportRequest) (*ImportResult, error)
+	// Batch applies the operations in a single transaction, either all of them are applied or
+	// none.
+	Batch(context.Context, *BatchRequest) (*BatchResult, error)
+	// Watch streams the changes to the graph made from now on, the stream ends if the client
+	// falls too far behind.
+	Watch(*WatchRequest, Relationer_WatchServer) error
+	mustEmbedUnimplementedRelationerServer()
+}
+
+// UnimplementedRelationerServer must be embedded to have forward compatible implementations.
+type UnimplementedRelationerServer struct {
+}
+
+func (UnimplementedRelationerServer) AddPerson(context.Context, *Person) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPerson not implemented")
+}
+func (UnimplementedRelationerServer) RemovePerson(context.Context, *PersonRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePerson not implemented")
+}
+func (UnimplementedRelationerServer) AddFriendship(context.Context, *Friendship) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFriendship not implemented")
+}
+func (UnimplementedRelationerServer) RemoveFriendship(context.Context, *Friendship) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriendship not implemented")
+}
+func (UnimplementedRelationerServer) GetPerson(context.Context, *PersonRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerson not implemented")
+}
+func (UnimplementedRelationerServer) FindPeople(context.Context, *FindPeopleRequest) (*People, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPeople not implemented")
+}
+func (UnimplementedRelationerServer) SearchPeople(context.Context, *SearchPeopleRequest) (*SearchPeopleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPeople not implemented")
+}
+func (UnimplementedRelationerServer) GetFriendship(context.Context, *GetFriendshipRequest) (*Friendship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendship not implemented")
+}
+func (UnimplementedRelationerServer) GetAll(*EdgeFilter, Relationer_GetAllServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (UnimplementedRelationerServer) GetPage(context.Context, *GetPageRequest) (*FriendshipPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPage not implemented")
+}
+func (UnimplementedRelationerServer) GetReciprocity(context.Context, *PairRequest) (*Reciprocity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReciprocity not implemented")
+}
+func (UnimplementedRelationerServer) GetDepth(context.Context, *GetDepthRequest) (*GetDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepth not implemented")
+}
+func (UnimplementedRelationerServer) GetPath(context.Context, *PairRequest) (*People, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPath not implemented")
+}
+func (UnimplementedRelationerServer) GetWeightedPath(context.Context, *PairRequest) (*WeightedPath, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeightedPath not implemented")
+}
+func (UnimplementedRelationerServer) GetPaths(context.Context, *GetPathsRequest) (*GetPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaths not implemented")
+}
+func (UnimplementedRelationerServer) GetMutual(context.Context, *PairRequest) (*People, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutual not implemented")
+}
+func (UnimplementedRelationerServer) GetNetwork(context.Context, *GetNetworkRequest) (*Network, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetwork not implemented")
+}
+func (UnimplementedRelationerServer) GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
+}
+func (UnimplementedRelationerServer) GetClusters(context.Context, *GetClustersRequest) (*GetClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusters not implemented")
+}
+func (UnimplementedRelationerServer) GetStats(context.Context, *emptypb.Empty) (*Stats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedRelationerServer) Query(context.Context, *QueryRequest) (*QueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedRelationerServer) GetCentrality(context.Context, *GetCentralityRequest) (*Centrality, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCentrality not implemented")
+}
+func (UnimplementedRelationerServer) GetTopCentrality(context.Context, *GetTopCentralityRequest) (*GetTopCentralityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopCentrality not implemented")
+}
+func (UnimplementedRelationerServer) Export(*ExportRequest, Relationer_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedRelationerServer) Import(context.Context, *ImportRequest) (*ImportResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedRelationerServer) Batch(context.Context, *BatchRequest) (*BatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedRelationerServer) Watch(*WatchRequest, Relationer_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedRelationerServer) mustEmbedUnimplementedRelationerServer() {}
+
+// UnsafeRelationerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationerServer will
+// result in compilation errors.
+type UnsafeRelationerServer interface {
+	mustEmbedUnimplementedRelationerServer()
+}
+
+func RegisterRelationerServer(s grpc.ServiceRegistrar, srv RelationerServer) {
+	s.RegisterService(&Relationer_ServiceDesc, srv)
+}
+
+func _Relationer_AddPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Person)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).AddPerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_AddPerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).AddPerson(ctx, req.(*Person))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_RemovePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).RemovePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_RemovePerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).RemovePerson(ctx, req.(*PersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_AddFriendship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Friendship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).AddFriendship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_AddFriendship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).AddFriendship(ctx, req.(*Friendship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_RemoveFriendship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Friendship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).RemoveFriendship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_RemoveFriendship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).RemoveFriendship(ctx, req.(*Friendship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetPerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetPerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetPerson(ctx, req.(*PersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_FindPeople_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPeopleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).FindPeople(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_FindPeople_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).FindPeople(ctx, req.(*FindPeopleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_SearchPeople_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPeopleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).SearchPeople(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_SearchPeople_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).SearchPeople(ctx, req.(*SearchPeopleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetFriendship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetFriendship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetFriendship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetFriendship(ctx, req.(*GetFriendshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EdgeFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelationerServer).GetAll(m, &relationerGetAllServer{stream})
+}
+
+type Relationer_GetAllServer interface {
+	Send(*Friendship) error
+	grpc.ServerStream
+}
+
+type relationerGetAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *relationerGetAllServer) Send(m *Friendship) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Relationer_GetPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetPage(ctx, req.(*GetPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetReciprocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetReciprocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetReciprocity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetReciprocity(ctx, req.(*PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetDepth(ctx, req.(*GetDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetPath(ctx, req.(*PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetWeightedPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetWeightedPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetWeightedPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetWeightedPath(ctx, req.(*PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetPaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetPaths(ctx, req.(*GetPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetMutual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetMutual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetMutual_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetMutual(ctx, req.(*PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetNetwork(ctx, req.(*GetNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetRecommendations(ctx, req.(*GetRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetClusters(ctx, req.(*GetClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_Query_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetCentrality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCentralityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetCentrality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetCentrality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetCentrality(ctx, req.(*GetCentralityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_GetTopCentrality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopCentralityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).GetTopCentrality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_GetTopCentrality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).GetTopCentrality(ctx, req.(*GetTopCentralityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelationerServer).Export(m, &relationerExportServer{stream})
+}
+
+type Relationer_ExportServer interface {
+	Send(*ExportChunk) error
+	grpc.ServerStream
+}
+
+type relationerExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *relationerExportServer) Send(m *ExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Relationer_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_Import_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationerServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relationer_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationerServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationer_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelationerServer).Watch(m, &relationerWatchServer{stream})
+}
+
+type Relationer_WatchServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type relationerWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *relationerWatchServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Relationer_ServiceDesc is the grpc.ServiceDesc for Relationer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Relationer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "relationer.v1.Relationer",
+	HandlerType: (*RelationerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddPerson",
+			Handler:    _Relationer_AddPerson_Handler,
+		},
+		{
+			MethodName: "RemovePerson",
+			Handler:    _Relationer_RemovePerson_Handler,
+		},
+		{
+			MethodName: "AddFriendship",
+			Handler:    _Relationer_AddFriendship_Handler,
+		},
+		{
+			MethodName: "RemoveFriendship",
+			Handler:    _Relationer_RemoveFriendship_Handler,
+		},
+		{
+			MethodName: "GetPerson",
+			Handler:    _Relationer_GetPerson_Handler,
+		},
+		{
+			MethodName: "FindPeople",
+			Handler:    _Relationer_FindPeople_Handler,
+		},
+		{
+			MethodName: "SearchPeople",
+			Handler:    _Relationer_SearchPeople_Handler,
+		},
+		{
+			MethodName: "GetFriendship",
+			Handler:    _Relationer_GetFriendship_Handler,
+		},
+		{
+			MethodName: "GetPage",
+			Handler:    _Relationer_GetPage_Handler,
+		},
+		{
+			MethodName: "GetReciprocity",
+			Handler:    _Relationer_GetReciprocity_Handler,
+		},
+		{
+			MethodName: "GetDepth",
+			Handler:    _Relationer_GetDepth_Handler,
+		},
+		{
+			MethodName: "GetPath",
+			Handler:    _Relationer_GetPath_Handler,
+		},
+		{
+			MethodName: "GetWeightedPath",
+			Handler:    _Relationer_GetWeightedPath_Handler,
+		},
+		{
+			MethodName: "GetPaths",
+			Handler:    _Relationer_GetPaths_Handler,
+		},
+		{
+			MethodName: "GetMutual",
+			Handler:    _Relationer_GetMutual_Handler,
+		},
+		{
+			MethodName: "GetNetwork",
+			Handler:    _Relationer_GetNetwork_Handler,
+		},
+		{
+			MethodName: "GetRecommendations",
+			Handler:    _Relationer_GetRecommendations_Handler,
+		},
+		{
+			MethodName: "GetClusters",
+			Handler:    _Relationer_GetClusters_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _Relationer_GetStats_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _Relationer_Query_Handler,
+		},
+		{
+			MethodName: "GetCentrality",
+			Handler:    _Relationer_GetCentrality_Handler,
+		},
+		{
+			MethodName: "GetTopCentrality",
+			Handler:    _Relationer_GetTopCentrality_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _Relationer_Import_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _Relationer_Batch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetAll",
+			Handler:       _Relationer_GetAll_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _Relationer_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _Relationer_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "relationer/v1/relationer.proto",
+}
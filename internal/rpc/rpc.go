@@ -0,0 +1,81 @@
+// Package rpc serves the graph over gRPC next to the rest api, for services preferring a
+// typed api, and implements a client for it satisfying service.GraphStore.
+//
+// the service is described by proto/relationer/v1/relationer.proto, the code generated from
+// it lives in package pb.
+package rpc
+
+//go:generate protoc -I ../../proto --go_out=../.. --go_opt=module=github.com/Lambels/relationer --go-grpc_out=../.. --go-grpc_opt=module=github.com/Lambels/relationer relationer/v1/relationer.proto
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Lambels/relationer/internal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultQueryTimeout bounds queries sent without a deadline, maxQueryTimeout bounds the
+	// deadline of queries. Both are short as queries hold the read lock of the graph.
+	defaultQueryTimeout = 2 * time.Second
+	maxQueryTimeout     = 5 * time.Second
+	// pageSize is the number of people whose friendships are fetched at a time when streaming.
+	pageSize = 1000
+	// chunkSize is the size of the chunks exports are streamed in.
+	chunkSize = 64 << 10
+)
+
+var codesByECode = map[internal.ECode]codes.Code{
+	internal.ECONFLICT: codes.AlreadyExists,
+	internal.EINVALID:  codes.InvalidArgument,
+	internal.ENOTFOUND: codes.NotFound,
+	internal.EINTERNAL: codes.Internal,
+}
+
+// toStatus converts err to a status error with the code matching its error code.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code, ok := codesByECode[internal.ErrorCode(err)]
+	if !ok {
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
+
+// fromStatus converts the status error err back to an internal error, errors without a
+// matching code (ie: the server is unreachable) are conflicts like for the rest client.
+func fromStatus(err error, op string) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return internal.WrapError(err, internal.ECONFLICT, op)
+	}
+	for ecode, code := range codesByECode {
+		if st.Code() == code {
+			return internal.Errorf(ecode, "%v", st.Message())
+		}
+	}
+	return internal.WrapError(errors.New(st.Message()), internal.ECONFLICT, op)
+}
+
+// queryContext bounds the deadline of queries by maxQueryTimeout, queries without one run for
+// up to defaultQueryTimeout.
+func queryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := defaultQueryTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	if timeout > maxQueryTimeout {
+		timeout = maxQueryTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,387 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/Lambels/relationer/internal"
+	"github.com/Lambels/relationer/internal/rpc/pb"
+	"github.com/Lambels/relationer/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// HandlerService implements the Relationer gRPC service over a store, mutations are published
+// to the broker like over the rest api.
+type HandlerService struct {
+	pb.UnimplementedRelationerServer
+
+	store  service.GraphStore
+	broker service.MessageBroker
+	events service.Subscriber
+}
+
+// NewHandlerService creates the gRPC api over store, mutations publish to broker and Watch
+// reads from events.
+func NewHandlerService(store service.GraphStore, broker service.MessageBroker, events service.Subscriber) *HandlerService {
+	return &HandlerService{
+		store:  store,
+		broker: broker,
+		events: events,
+	}
+}
+
+// Register registers the service on srv.
+func (h *HandlerService) Register(srv grpc.ServiceRegistrar) {
+	pb.RegisterRelationerServer(srv, h)
+}
+
+func (h *HandlerService) AddPerson(ctx context.Context, req *pb.Person) (*pb.Person, error) {
+	person := personFromPB(req)
+	if err := h.store.AddPerson(ctx, person); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := h.broker.CreatedPerson(ctx, person); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return personToPB(person), nil
+}
+
+func (h *HandlerService) RemovePerson(ctx context.Context, req *pb.PersonRequest) (*emptypb.Empty, error) {
+	if err := h.store.RemovePerson(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := h.broker.DeletedPerson(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *HandlerService) AddFriendship(ctx context.Context, req *pb.Friendship) (*emptypb.Empty, error) {
+	friendship := friendshipFromPB(req).WithDefaults() // published events carry the stored weight and type.
+	if err := h.store.AddFriendship(ctx, friendship); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := h.broker.CreatedFriendship(ctx, friendship); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *HandlerService) RemoveFriendship(ctx context.Context, req *pb.Friendship) (*emptypb.Empty, error) {
+	friendship := friendshipFromPB(req).WithDefaults()
+	if err := h.store.RemoveFriendship(ctx, friendship); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := h.broker.DeletedFriendship(ctx, friendship); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *HandlerService) GetPerson(ctx context.Context, req *pb.PersonRequest) (*pb.Person, error) {
+	person, err := h.store.GetPerson(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return personToPB(person), nil
+}
+
+func (h *HandlerService) FindPeople(ctx context.Context, req *pb.FindPeopleRequest) (*pb.People, error) {
+	where := convert(req.GetWhere(), func(p *pb.Property) internal.Property {
+		return internal.Property{Key: p.GetKey(), Value: p.GetValue()}
+	})
+
+	people, err := h.store.FindPeople(ctx, where)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return peopleToPB(people), nil
+}
+
+func (h *HandlerService) SearchPeople(ctx context.Context, req *pb.SearchPeopleRequest) (*pb.SearchPeopleResponse, error) {
+	results, err := h.store.SearchPeople(ctx, req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.SearchPeopleResponse{Results: convert(results, searchResultToPB)}, nil
+}
+
+func (h *HandlerService) GetFriendship(ctx context.Context, req *pb.GetFriendshipRequest) (*pb.Friendship, error) {
+	friendship, err := h.store.GetFriendship(ctx, req.GetId(), filterFromPB(req.GetFilter()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return friendshipToPB(friendship), nil
+}
+
+// GetAll streams the friendships a page at a time so the store isnt held for the whole
+// stream.
+func (h *HandlerService) GetAll(req *pb.EdgeFilter, stream pb.Relationer_GetAllServer) error {
+	filter := filterFromPB(req)
+	page := internal.Page{Limit: pageSize}
+	for {
+		res, err := h.store.GetPage(stream.Context(), filter, page)
+		if err != nil {
+			return toStatus(err)
+		}
+
+		for _, friendship := range res.Friendships {
+			if err := stream.Send(friendshipToPB(friendship)); err != nil {
+				return err
+			}
+		}
+
+		if res.NextCursor == 0 {
+			return nil
+		}
+		page.Cursor = res.NextCursor
+	}
+}
+
+func (h *HandlerService) GetPage(ctx context.Context, req *pb.GetPageRequest) (*pb.FriendshipPage, error) {
+	page := internal.Page{Cursor: req.GetCursor(), Limit: int(req.GetLimit())}
+	res, err := h.store.GetPage(ctx, filterFromPB(req.GetFilter()), page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.FriendshipPage{
+		Friendships: convert(res.Friendships, friendshipToPB),
+		NextCursor:  res.NextCursor,
+	}, nil
+}
+
+func (h *HandlerService) GetReciprocity(ctx context.Context, req *pb.PairRequest) (*pb.Reciprocity, error) {
+	reciprocity, err := h.store.GetReciprocity(ctx, req.GetId1(), req.GetId2())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return reciprocityToPB(reciprocity), nil
+}
+
+func (h *HandlerService) GetDepth(ctx context.Context, req *pb.GetDepthRequest) (*pb.GetDepthResponse, error) {
+	depth, err := h.store.GetDepth(ctx, req.GetId1(), req.GetId2(), filterFromPB(req.GetFilter()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.GetDepthResponse{Depth: int32(depth)}, nil
+}
+
+func (h *HandlerService) GetPath(ctx context.Context, req *pb.PairRequest) (*pb.People, error) {
+	path, err := h.store.GetPath(ctx, req.GetId1(), req.GetId2())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return peopleToPB(path), nil
+}
+
+func (h *HandlerService) GetWeightedPath(ctx context.Context, req *pb.PairRequest) (*pb.WeightedPath, error) {
+	path, err := h.store.GetWeightedPath(ctx, req.GetId1(), req.GetId2())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.WeightedPath{Distance: path.Distance, Path: convert(path.Path, personToPB)}, nil
+}
+
+func (h *HandlerService) GetPaths(ctx context.Context, req *pb.GetPathsRequest) (*pb.GetPathsResponse, error) {
+	paths, err := h.store.GetPaths(ctx, req.GetId1(), req.GetId2(), int(req.GetMaxLength()), int(req.GetLimit()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.GetPathsResponse{Paths: convert(paths, peopleToPB)}, nil
+}
+
+func (h *HandlerService) GetMutual(ctx context.Context, req *pb.PairRequest) (*pb.People, error) {
+	mutual, err := h.store.GetMutual(ctx, req.GetId1(), req.GetId2())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return peopleToPB(mutual), nil
+}
+
+func (h *HandlerService) GetNetwork(ctx context.Context, req *pb.GetNetworkRequest) (*pb.Network, error) {
+	network, err := h.store.GetNetwork(ctx, req.GetId(), int(req.GetDepth()), req.GetWithEdges())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return networkToPB(network), nil
+}
+
+func (h *HandlerService) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResponse, error) {
+	algorithm := internal.Algorithm(req.GetAlgorithm())
+	recommendations, err := h.store.GetRecommendations(ctx, req.GetId(), int(req.GetLimit()), algorithm)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.GetRecommendationsResponse{
+		Recommendations: convert(recommendations, func(r internal.Recommendation) *pb.Recommendation {
+			return &pb.Recommendation{Person: personToPB(r.Person), Score: r.Score}
+		}),
+	}, nil
+}
+
+func (h *HandlerService) GetClusters(ctx context.Context, req *pb.GetClustersRequest) (*pb.GetClustersResponse, error) {
+	clusters, err := h.store.GetClusters(ctx, internal.ClusterKind(req.GetKind()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.GetClustersResponse{Clusters: convert(clusters, clusterToPB)}, nil
+}
+
+func (h *HandlerService) GetStats(ctx context.Context, _ *emptypb.Empty) (*pb.Stats, error) {
+	stats, err := h.store.GetStats(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return statsToPB(stats), nil
+}
+
+func (h *HandlerService) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResult, error) {
+	ctx, cancel := queryContext(ctx)
+	defer cancel()
+
+	res, err := h.store.Query(ctx, req.GetQuery())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return queryResultToPB(res), nil
+}
+
+func (h *HandlerService) GetCentrality(ctx context.Context, req *pb.GetCentralityRequest) (*pb.Centrality, error) {
+	centrality, err := h.store.GetCentrality(ctx, req.GetId(), req.GetDamping())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return centralityToPB(centrality), nil
+}
+
+func (h *HandlerService) GetTopCentrality(ctx context.Context, req *pb.GetTopCentralityRequest) (*pb.GetTopCentralityResponse, error) {
+	metric := internal.Metric(req.GetMetric())
+	people, err := h.store.GetTopCentrality(ctx, metric, int(req.GetLimit()), req.GetDamping())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.GetTopCentralityResponse{
+		Ranked: convert(people, func(r internal.Ranked) *pb.Ranked {
+			return &pb.Ranked{Person: personToPB(r.Person), Score: r.Score}
+		}),
+	}, nil
+}
+
+// Export buffers the export so failures are reported before any chunk is sent.
+func (h *HandlerService) Export(req *pb.ExportRequest, stream pb.Relationer_ExportServer) error {
+	var buf bytes.Buffer
+	format := internal.ExportFormat(req.GetFormat())
+	if err := h.store.Export(stream.Context(), format, filterFromPB(req.GetFilter()), &buf); err != nil {
+		return toStatus(err)
+	}
+
+	for buf.Len() != 0 {
+		if err := stream.Send(&pb.ExportChunk{Data: buf.Next(chunkSize)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *HandlerService) Import(ctx context.Context, req *pb.ImportRequest) (*pb.ImportResult, error) {
+	res, err := h.store.Import(ctx, importFromPB(req))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// listeners see the import as the equivalent single adds.
+	for _, person := range res.People {
+		if err := h.broker.CreatedPerson(ctx, person); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	for _, friendship := range res.Friendships {
+		if err := h.broker.CreatedFriendship(ctx, friendship); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return importResultToPB(res), nil
+}
+
+func (h *HandlerService) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResult, error) {
+	res, err := h.store.Batch(ctx, convert(req.GetOps(), batchOpFromPB))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// events are only published once the whole batch is applied.
+	for _, op := range res.Results {
+		switch op.Op {
+		case internal.AddPersonOp:
+			err = h.broker.CreatedPerson(ctx, op.Person)
+		case internal.AddFriendshipOp:
+			err = h.broker.CreatedFriendship(ctx, *op.Friendship)
+		case internal.RemoveFriendshipOp:
+			err = h.broker.DeletedFriendship(ctx, *op.Friendship)
+		case internal.RemovePersonOp:
+			err = h.broker.DeletedPerson(ctx, op.Person.ID)
+		}
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return batchResultToPB(res), nil
+}
+
+// Watch streams the events with routing keys matching the requested binding keys until the
+// client leaves, ending the stream with RESOURCE_EXHAUSTED when the client falls too far
+// behind.
+func (h *HandlerService) Watch(req *pb.WatchRequest, stream pb.Relationer_WatchServer) error {
+	// the headers tell the client the subscription is in place.
+	ctx := stream.Context()
+	events := h.events.Subscribe(ctx)
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for event := range events {
+		if !internal.MatchAny(req.GetKeys(), event.Key) {
+			continue
+		}
+		if err := stream.Send(eventToPB(event)); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	return status.Error(codes.ResourceExhausted, "fell too far behind the events")
+}
@@ -0,0 +1,8 @@
+package internal
+
+// SearchResult represents a person matching a name search alongside their score, the score
+// is in (0, 1] where 1 is an exact match.
+type SearchResult struct {
+	Person *Person `json:"person"`
+	Score  float64 `json:"score"`
+}
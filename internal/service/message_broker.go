@@ -9,5 +9,13 @@ import (
 type MessageBroker interface {
 	CreatedPerson(context.Context, *internal.Person) error
 	CreatedFriendship(context.Context, internal.Friendship) error
+	DeletedFriendship(context.Context, internal.Friendship) error
 	DeletedPerson(context.Context, int64) error
 }
+
+// Subscriber streams the events published to the message broker by the current process.
+type Subscriber interface {
+	// Subscribe returns the events published from now on, the channel is closed once ctx is
+	// done.
+	Subscribe(context.Context) <-chan internal.Event
+}
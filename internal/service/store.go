@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/Lambels/relationer/internal"
 )
@@ -13,9 +14,29 @@ type Store interface {
 
 	AddFriendship(context.Context, internal.Friendship) error
 
+	RemoveFriendship(context.Context, internal.Friendship) error
+
 	RemovePerson(context.Context, int64) error
 }
 
+// BulkStore is a store which can add many people and friendships at once, either all of
+// them are stored or none.
+//
+// the ids of the people are generated before the links between them are stored.
+type BulkStore interface {
+	Store
+
+	AddBulk(context.Context, []*internal.Person, []internal.Link) error
+}
+
+// BatchStore is a store which can apply many mutations in a single transaction, either all
+// of them are applied or none.
+type BatchStore interface {
+	Store
+
+	ApplyBatch(context.Context, []internal.Mutation) error
+}
+
 // GraphStore is a bi-directional graph ds representing
 // relation-ships between people.
 //
@@ -24,11 +45,44 @@ type Store interface {
 type GraphStore interface {
 	Store
 
-	GetDepth(context.Context, int64, int64) (int, error)
+	GetDepth(context.Context, int64, int64, internal.EdgeFilter) (int, error)
 
-	GetFriendship(context.Context, int64) (internal.Friendship, error)
+	GetFriendship(context.Context, int64, internal.EdgeFilter) (internal.Friendship, error)
 
 	GetPerson(context.Context, int64) (*internal.Person, error)
 
-	GetAll(context.Context) ([]internal.Friendship, error)
+	FindPeople(context.Context, []internal.Property) ([]*internal.Person, error)
+
+	SearchPeople(context.Context, string, int) ([]internal.SearchResult, error)
+
+	GetAll(context.Context, internal.EdgeFilter) ([]internal.Friendship, error)
+
+	GetPage(context.Context, internal.EdgeFilter, internal.Page) (internal.FriendshipPage, error)
+
+	Export(context.Context, internal.ExportFormat, internal.EdgeFilter, io.Writer) error
+	Import(context.Context, *internal.Import) (internal.ImportResult, error)
+	Batch(context.Context, []internal.BatchOp) (internal.BatchResult, error)
+
+	GetReciprocity(context.Context, int64, int64) (internal.Reciprocity, error)
+
+	GetPath(context.Context, int64, int64) ([]*internal.Person, error)
+
+	GetWeightedPath(context.Context, int64, int64) (internal.WeightedPath, error)
+
+	GetPaths(context.Context, int64, int64, int, int) ([][]*internal.Person, error)
+
+	GetNetwork(context.Context, int64, int, bool) (internal.Network, error)
+
+	GetMutual(context.Context, int64, int64) ([]*internal.Person, error)
+
+	GetRecommendations(context.Context, int64, int, internal.Algorithm) ([]internal.Recommendation, error)
+
+	GetClusters(context.Context, internal.ClusterKind) ([]internal.Cluster, error)
+	GetStats(context.Context) (internal.Stats, error)
+
+	Query(context.Context, string) (internal.QueryResult, error)
+
+	GetCentrality(context.Context, int64, float64) (internal.Centrality, error)
+
+	GetTopCentrality(context.Context, internal.Metric, int, float64) ([]internal.Ranked, error)
 }
@@ -0,0 +1,30 @@
+package internal
+
+// Stats represents graph-wide statistics.
+//
+// distances (diameter and radius) ignore friendship direction and are measured over the
+// largest component, when it has more people than can be searched from exhaustively they
+// are estimated from a sample of people and Estimated is set.
+type Stats struct {
+	People           int                  `json:"people"`
+	Friendships      int                  `json:"friendships"`   // people linked by at least one relationship, per direction.
+	Relationships    map[RelationType]int `json:"relationships"` // relationships per type.
+	Isolated         int                  `json:"isolated"`      // people without any friendship either way.
+	Density          float64              `json:"density"`
+	MeanDegree       float64              `json:"meanDegree"` // mean out-degree, equal to the mean in-degree.
+	InDegrees        []DegreeCount        `json:"inDegrees"`
+	OutDegrees       []DegreeCount        `json:"outDegrees"`
+	Components       int                  `json:"components"` // weak components.
+	StrongComponents int                  `json:"strongComponents"`
+	LargestComponent int                  `json:"largestComponent"`
+	Diameter         int                  `json:"diameter"`
+	Radius           int                  `json:"radius"`
+	Estimated        bool                 `json:"estimated"`
+	Clustering       float64              `json:"clustering"` // average local clustering coefficient.
+}
+
+// DegreeCount is a bucket of a degree distribution histogram.
+type DegreeCount struct {
+	Degree int `json:"degree"`
+	People int `json:"people"`
+}